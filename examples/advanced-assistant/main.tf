@@ -16,7 +16,12 @@ provider "vapi" {
 resource "vapi_assistant" "advanced" {
   name           = "Advanced AI Assistant"
   first_message  = "Welcome! I'm your advanced AI assistant. I can help you with a variety of tasks."
-  system_message = <<EOF
+
+  # Model configuration
+  model = {
+    provider_type               = "openai"
+    model                       = "gpt-4"
+    system_prompt               = <<EOF
 You are an advanced AI assistant with expertise in multiple domains. 
 You should:
 - Be helpful, harmless, and honest
@@ -24,11 +29,6 @@ You should:
 - Ask clarifying questions when needed
 - Maintain a professional but friendly tone
 EOF
-
-  # Model configuration
-  model = {
-    provider_type               = "openai"
-    model                       = "gpt-4"
     temperature                 = 0.7
     max_tokens                  = 1000
     emotion_recognition_enabled = true
@@ -41,11 +41,11 @@ variable "phone_number" {
 resource "vapi_assistant" "advanced_phone_assistant" {
   name          = "Advanced Phone Support Assistant"
   first_message = "Hello! Welcome to our support line. I'm an AI assistant here to help you. How can I assist you today?"
-  system_message = "You are an advanced customer support AI assistant for phone conversations. You can help with technical issues, billing questions, and general inquiries. Be professional, empathetic, and thorough in your responses while keeping them conversational for voice interaction."
 
   model = {
     provider_type               = "openai"
     model                      = "gpt-4"
+    system_prompt              = "You are an advanced customer support AI assistant for phone conversations. You can help with technical issues, billing questions, and general inquiries. Be professional, empathetic, and thorough in your responses while keeping them conversational for voice interaction."
     temperature                = 0.7
     max_tokens                 = 1000
     emotion_recognition_enabled = true
@@ -73,13 +73,15 @@ resource "vapi_assistant" "advanced_phone_assistant" {
 
 # Phone number with Twilio provider configuration
 resource "vapi_phone_number" "twilio_support_line" {
-  number             = var.phone_number
-  name               = "Twilio Customer Support Line"
-  assistant_id       = vapi_assistant.advanced_phone_assistant.id
-  provider_type      = "twilio"
-  twilio_account_sid = var.twilio_account_sid
-  twilio_auth_token  = var.twilio_auth_token
-  
+  number       = var.phone_number
+  name         = "Twilio Customer Support Line"
+  assistant_id = vapi_assistant.advanced_phone_assistant.id
+
+  twilio = {
+    account_sid = var.twilio_account_sid
+    auth_token  = var.twilio_auth_token
+  }
+
   # Webhook configuration
   server_url        = "https://yourapp.com/vapi/webhook"
   server_url_secret = var.webhook_secret
@@ -26,16 +26,14 @@ resource "vapi_assistant" "webhook_assistant" {
   # First message the assistant will say
   first_message = "Hello! I'm an assistant configured with webhook support. How can I help you today?"
 
-  # System message to guide the assistant's behavior
-  system_message = "You are a helpful assistant with webhook event reporting. Be concise and friendly in your responses."
-
   # Server URL for webhook events
   server_url = var.webhook_url
 
-  # Optional model configuration
+  # Model configuration, including the system prompt that guides the assistant's behavior
   model = {
     provider_type = "openai"
     model         = "gpt-4o-mini"
+    system_prompt = "You are a helpful assistant with webhook event reporting. Be concise and friendly in your responses."
     temperature   = 0.7
     max_tokens    = 500
   }
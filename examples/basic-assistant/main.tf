@@ -19,8 +19,12 @@ resource "vapi_assistant" "basic" {
   # Optional: First message the assistant will say
   first_message = "Hello! How can I help you today?"
 
-  # Optional: System message to guide the assistant's behavior
-  system_message = "You are a helpful assistant. Be concise and friendly in your responses."
+  # Optional: Model and system prompt to guide the assistant's behavior
+  model = {
+    provider_type = "openai"
+    model         = "gpt-4o-mini"
+    system_prompt = "You are a helpful assistant. Be concise and friendly in your responses."
+  }
 }
 
 # Import and update an existing phone number to use this assistant
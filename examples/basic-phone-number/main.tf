@@ -16,11 +16,11 @@ provider "vapi" {
 resource "vapi_assistant" "phone_assistant" {
   name          = "Phone Support Assistant"
   first_message = "Hello! Thank you for calling. How can I help you today?"
-  system_message = "You are a helpful customer support assistant for a phone conversation. Be concise and clear in your responses."
 
   model = {
     provider_type = "openai"
     model    = "gpt-4o-mini"
+    system_prompt = "You are a helpful customer support assistant for a phone conversation. Be concise and clear in your responses."
     temperature = 0.7
   }
 
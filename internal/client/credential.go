@@ -0,0 +1,209 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Credential represents a Vapi credential, the API key or account Vapi uses to
+// reach a model, voice, transcriber, telephony or storage provider. Which
+// fields apply depends on Provider. The API never returns secret fields.
+type Credential struct {
+	ID       string `json:"id,omitempty"`
+	Provider string `json:"provider,omitempty"`
+	Name     string `json:"name,omitempty"`
+
+	// API key providers, Twilio and Vonage
+	APIKey    string `json:"apiKey,omitempty"`
+	APISecret string `json:"apiSecret,omitempty"`
+
+	// Twilio
+	AccountSid string `json:"accountSid,omitempty"`
+	AuthToken  string `json:"authToken,omitempty"`
+
+	// S3
+	AWSAccessKeyID     string `json:"awsAccessKeyId,omitempty"`
+	AWSSecretAccessKey string `json:"awsSecretAccessKey,omitempty"`
+	Region             string `json:"region,omitempty"`
+	S3BucketName       string `json:"s3BucketName,omitempty"`
+	S3PathPrefix       string `json:"s3PathPrefix,omitempty"`
+
+	// GCP
+	GCPKey     map[string]interface{} `json:"gcpKey,omitempty"`
+	BucketPlan *CredentialBucketPlan  `json:"bucketPlan,omitempty"`
+
+	OrgID     string `json:"orgId,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the credential, adding explicit nulls for NullFields
+func (c Credential) MarshalJSON() ([]byte, error) {
+	type credential Credential
+	return marshalWithNullFields(credential(c), c.NullFields)
+}
+
+// CredentialBucketPlan represents the storage bucket a GCP credential uploads call artifacts to
+type CredentialBucketPlan struct {
+	Name   string `json:"name"`
+	Region string `json:"region,omitempty"`
+	Path   string `json:"path,omitempty"`
+}
+
+// CreateCredential creates a new credential
+func (c *VapiClient) CreateCredential(ctx context.Context, credential *Credential) (*Credential, error) {
+	url := fmt.Sprintf("%s/credential", c.BaseURL)
+
+	jsonData, err := json.Marshal(credential)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling credential: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, body)
+	}
+
+	var createdCredential Credential
+	if err := json.Unmarshal(body, &createdCredential); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &createdCredential, nil
+}
+
+// GetCredential retrieves a credential by ID
+func (c *VapiClient) GetCredential(ctx context.Context, id string) (*Credential, error) {
+	url := fmt.Sprintf("%s/credential/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "credential", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var credential Credential
+	if err := json.Unmarshal(body, &credential); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &credential, nil
+}
+
+// UpdateCredential updates an existing credential
+func (c *VapiClient) UpdateCredential(ctx context.Context, id string, credential *Credential) (*Credential, error) {
+	url := fmt.Sprintf("%s/credential/%s", c.BaseURL, id)
+
+	jsonData, err := json.Marshal(credential)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling credential: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "credential", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var updatedCredential Credential
+	if err := json.Unmarshal(body, &updatedCredential); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updatedCredential, nil
+}
+
+// DeleteCredential deletes a credential by ID
+func (c *VapiClient) DeleteCredential(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/credential/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "credential", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
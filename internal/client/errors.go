@@ -0,0 +1,149 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// ErrNotFound is the sentinel matched by errors.Is for every NotFoundError
+var ErrNotFound = errors.New("not found")
+
+// NotFoundError is returned when the requested Vapi object does not exist
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+// Error implements the error interface
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found", e.Resource, e.ID)
+}
+
+// Is makes NotFoundError match ErrNotFound
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// IsNotFound reports whether err is, or wraps, a NotFoundError. Resources use
+// it in Read to drop objects deleted outside of Terraform from state, so that
+// the next plan creates them again.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// requestIDHeaders are the response headers that may carry a request or trace ID, in order of preference
+var requestIDHeaders = []string{"X-Request-Id", "X-Vapi-Request-Id", "X-Trace-Id", "Cf-Ray"}
+
+// APIError is returned when the Vapi API responds with an unexpected status code
+type APIError struct {
+	StatusCode int
+	// Messages holds the individual messages from the response body. Validation
+	// failures return one message per invalid field.
+	Messages []string
+	// ErrorText is the short error name from the response body (e.g. "Bad Request")
+	ErrorText string
+	RequestID string
+	Header    http.Header
+	Body      []byte
+}
+
+// apiErrorBody is the error payload returned by Vapi. The message is either a
+// single string or a list of validation messages.
+type apiErrorBody struct {
+	Message    json.RawMessage `json:"message"`
+	Error      string          `json:"error"`
+	StatusCode int             `json:"statusCode"`
+}
+
+// newAPIError builds an APIError from a response and its already read body
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	}
+
+	for _, header := range requestIDHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			apiErr.RequestID = value
+			break
+		}
+	}
+
+	var errorBody apiErrorBody
+	if err := json.Unmarshal(body, &errorBody); err != nil {
+		// Not a JSON error payload, keep the raw text as the message
+		if text := strings.TrimSpace(string(body)); text != "" {
+			apiErr.Messages = []string{text}
+		}
+		return apiErr
+	}
+
+	apiErr.ErrorText = errorBody.Error
+
+	var messages []string
+	var message string
+	if err := json.Unmarshal(errorBody.Message, &messages); err == nil {
+		apiErr.Messages = messages
+	} else if err := json.Unmarshal(errorBody.Message, &message); err == nil && message != "" {
+		apiErr.Messages = []string{message}
+	}
+
+	return apiErr
+}
+
+// Error implements the error interface with a readable summary of the response
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Vapi API returned status %d", e.StatusCode)
+	if e.ErrorText != "" {
+		fmt.Fprintf(&b, " (%s)", e.ErrorText)
+	}
+	if len(e.Messages) > 0 {
+		fmt.Fprintf(&b, ": %s", strings.Join(e.Messages, "; "))
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " [request ID: %s]", e.RequestID)
+	}
+
+	return b.String()
+}
+
+// FieldError is a validation message that refers to a single request field
+type FieldError struct {
+	// Field is the dotted path of the field in the request payload (e.g. "model.temperature")
+	Field   string
+	Message string
+}
+
+// fieldMessagePattern matches validation messages such as
+// "model.temperature must not be greater than 2" and "property foo should not exist"
+var fieldMessagePattern = regexp.MustCompile(`^(?:property )?([A-Za-z][\w.]*) (?:must|should|is|has) `)
+
+// FieldErrors returns the validation messages that name a request field.
+// Messages that don't refer to a specific field are left out.
+func (e *APIError) FieldErrors() []FieldError {
+	if e.StatusCode != http.StatusBadRequest {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	for _, message := range e.Messages {
+		match := fieldMessagePattern.FindStringSubmatch(message)
+		if match == nil {
+			continue
+		}
+
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   match[1],
+			Message: message,
+		})
+	}
+
+	return fieldErrors
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		header        http.Header
+		body          string
+		wantMessages  []string
+		wantErrorText string
+		wantRequestID string
+		wantError     string
+	}{
+		{
+			name:          "validation messages",
+			status:        http.StatusBadRequest,
+			body:          `{"message":["model.temperature must not be greater than 2","name must be a string"],"error":"Bad Request","statusCode":400}`,
+			wantMessages:  []string{"model.temperature must not be greater than 2", "name must be a string"},
+			wantErrorText: "Bad Request",
+			wantError:     "Vapi API returned status 400 (Bad Request): model.temperature must not be greater than 2; name must be a string",
+		},
+		{
+			name:          "single message",
+			status:        http.StatusUnauthorized,
+			body:          `{"message":"Invalid API key","error":"Unauthorized","statusCode":401}`,
+			wantMessages:  []string{"Invalid API key"},
+			wantErrorText: "Unauthorized",
+			wantError:     "Vapi API returned status 401 (Unauthorized): Invalid API key",
+		},
+		{
+			name:      "json without message",
+			status:    http.StatusInternalServerError,
+			body:      `{"statusCode":500}`,
+			wantError: "Vapi API returned status 500",
+		},
+		{
+			name:         "plain text body",
+			status:       http.StatusBadGateway,
+			body:         "  upstream connect error  \n",
+			wantMessages: []string{"upstream connect error"},
+			wantError:    "Vapi API returned status 502: upstream connect error",
+		},
+		{
+			name:         "html body",
+			status:       http.StatusServiceUnavailable,
+			body:         "<html><body>Service Unavailable</body></html>",
+			wantMessages: []string{"<html><body>Service Unavailable</body></html>"},
+			wantError:    "Vapi API returned status 503: <html><body>Service Unavailable</body></html>",
+		},
+		{
+			name:      "empty body",
+			status:    http.StatusNotImplemented,
+			body:      "",
+			wantError: "Vapi API returned status 501",
+		},
+		{
+			name:   "request ID",
+			status: http.StatusBadRequest,
+			header: http.Header{
+				"Cf-Ray":            []string{"ray-1"},
+				"X-Vapi-Request-Id": []string{"req-1"},
+			},
+			body:          `{"message":"bad","error":"Bad Request"}`,
+			wantMessages:  []string{"bad"},
+			wantErrorText: "Bad Request",
+			wantRequestID: "req-1",
+			wantError:     "Vapi API returned status 400 (Bad Request): bad [request ID: req-1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.header
+			if header == nil {
+				header = http.Header{}
+			}
+
+			apiErr := newAPIError(&http.Response{StatusCode: tt.status, Header: header}, []byte(tt.body))
+
+			if !reflect.DeepEqual(apiErr.Messages, tt.wantMessages) {
+				t.Errorf("got messages %q, want %q", apiErr.Messages, tt.wantMessages)
+			}
+			if apiErr.ErrorText != tt.wantErrorText {
+				t.Errorf("got error text %q, want %q", apiErr.ErrorText, tt.wantErrorText)
+			}
+			if apiErr.RequestID != tt.wantRequestID {
+				t.Errorf("got request ID %q, want %q", apiErr.RequestID, tt.wantRequestID)
+			}
+			if apiErr.Error() != tt.wantError {
+				t.Errorf("got error %q, want %q", apiErr.Error(), tt.wantError)
+			}
+		})
+	}
+}
+
+func TestAPIErrorFieldErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		messages []string
+		want     []FieldError
+	}{
+		{
+			name:     "top level field",
+			status:   http.StatusBadRequest,
+			messages: []string{"name must be shorter than or equal to 40 characters"},
+			want:     []FieldError{{Field: "name", Message: "name must be shorter than or equal to 40 characters"}},
+		},
+		{
+			name:     "nested field with list index",
+			status:   http.StatusBadRequest,
+			messages: []string{"model.tools.0.function.name must be a string"},
+			want:     []FieldError{{Field: "model.tools.0.function.name", Message: "model.tools.0.function.name must be a string"}},
+		},
+		{
+			name:     "unknown property",
+			status:   http.StatusBadRequest,
+			messages: []string{"property voice.pitch should not exist"},
+			want:     []FieldError{{Field: "voice.pitch", Message: "property voice.pitch should not exist"}},
+		},
+		{
+			name:     "is and has verbs",
+			status:   http.StatusBadRequest,
+			messages: []string{"serverUrl is not a valid URL", "members has duplicate entries"},
+			want: []FieldError{
+				{Field: "serverUrl", Message: "serverUrl is not a valid URL"},
+				{Field: "members", Message: "members has duplicate entries"},
+			},
+		},
+		{
+			name:     "messages without a field are skipped",
+			status:   http.StatusBadRequest,
+			messages: []string{"Couldn't create assistant.", "maxDurationSeconds must be a number", "1 error occurred"},
+			want:     []FieldError{{Field: "maxDurationSeconds", Message: "maxDurationSeconds must be a number"}},
+		},
+		{
+			name:     "not a validation failure",
+			status:   http.StatusInternalServerError,
+			messages: []string{"name must be a string"},
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := &APIError{StatusCode: tt.status, Messages: tt.messages}
+
+			if got := apiErr.FieldErrors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	err := fmt.Errorf("error reading assistant: %w", &NotFoundError{Resource: "assistant", ID: "123"})
+
+	if !IsNotFound(err) {
+		t.Error("wrapped NotFoundError not detected")
+	}
+	if IsNotFound(&APIError{StatusCode: http.StatusNotFound}) {
+		t.Error("APIError reported as NotFoundError")
+	}
+}
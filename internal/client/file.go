@@ -0,0 +1,250 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// File represents a file uploaded to Vapi, such as a document for a knowledge base
+type File struct {
+	ID              string                 `json:"id,omitempty"`
+	Name            string                 `json:"name,omitempty"`
+	OriginalName    string                 `json:"originalName,omitempty"`
+	Status          string                 `json:"status,omitempty"`
+	Bytes           *int64                 `json:"bytes,omitempty"`
+	Mimetype        string                 `json:"mimetype,omitempty"`
+	URL             string                 `json:"url,omitempty"`
+	ParsedTextURL   string                 `json:"parsedTextUrl,omitempty"`
+	ParsedTextBytes *int64                 `json:"parsedTextBytes,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	OrgID           string                 `json:"orgId,omitempty"`
+	CreatedAt       string                 `json:"createdAt,omitempty"`
+	UpdatedAt       string                 `json:"updatedAt,omitempty"`
+}
+
+// fileUpdate is the payload for renaming a file, the only change Vapi accepts after upload
+type fileUpdate struct {
+	Name string `json:"name"`
+}
+
+// quoteEscaper escapes a file name for the Content-Disposition header of a multipart part
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// UploadFile uploads the file at sourcePath as a multipart request under the
+// given name. The file is streamed from disk rather than buffered, and reopened
+// when the request is retried. The per-attempt request timeout doesn't apply,
+// so ctx should carry a deadline.
+func (c *VapiClient) UploadFile(ctx context.Context, name, sourcePath string) (*File, error) {
+	url := fmt.Sprintf("%s/file", c.BaseURL)
+
+	info, err := os.Stat(sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	// Render the multipart framing around the file content up front, so the
+	// request has a known length and the file itself never sits in memory
+	var framing bytes.Buffer
+	writer := multipart.NewWriter(&framing)
+
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(name)))
+	header.Set("Content-Type", contentType)
+	if _, err := writer.CreatePart(header); err != nil {
+		return nil, fmt.Errorf("error encoding file: %w", err)
+	}
+	prefix := bytes.Clone(framing.Bytes())
+
+	framing.Reset()
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error encoding file: %w", err)
+	}
+	suffix := bytes.Clone(framing.Bytes())
+
+	openBody := func() (io.ReadCloser, error) {
+		file, err := os.Open(sourcePath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading file: %w", err)
+		}
+
+		return &multipartFileBody{
+			Reader: io.MultiReader(bytes.NewReader(prefix), file, bytes.NewReader(suffix)),
+			file:   file,
+		}, nil
+	}
+
+	body, err := openBody()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(contextWithoutAttemptTimeout(ctx), "POST", url, body)
+	if err != nil {
+		body.Close()
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.ContentLength = int64(len(prefix)) + info.Size() + int64(len(suffix))
+	req.GetBody = openBody
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, respBody)
+	}
+
+	var uploadedFile File
+	if err := json.Unmarshal(respBody, &uploadedFile); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &uploadedFile, nil
+}
+
+// multipartFileBody is a multipart request body that closes the streamed file once the request is done
+type multipartFileBody struct {
+	io.Reader
+	file *os.File
+}
+
+func (b *multipartFileBody) Close() error {
+	return b.file.Close()
+}
+
+// GetFile retrieves a file by ID
+func (c *VapiClient) GetFile(ctx context.Context, id string) (*File, error) {
+	url := fmt.Sprintf("%s/file/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "file", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var file File
+	if err := json.Unmarshal(body, &file); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &file, nil
+}
+
+// RenameFile changes the name of an uploaded file
+func (c *VapiClient) RenameFile(ctx context.Context, id, name string) (*File, error) {
+	url := fmt.Sprintf("%s/file/%s", c.BaseURL, id)
+
+	jsonData, err := json.Marshal(fileUpdate{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling file: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "file", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var updatedFile File
+	if err := json.Unmarshal(body, &updatedFile); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updatedFile, nil
+}
+
+// DeleteFile deletes a file by ID
+func (c *VapiClient) DeleteFile(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/file/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "file", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
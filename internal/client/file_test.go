@@ -0,0 +1,208 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// uploadedPart is the file part of a multipart upload as the server received it
+type uploadedPart struct {
+	contentLength int64
+	bodyLength    int
+	filename      string
+	contentType   string
+	content       string
+}
+
+// uploadServer replies to uploads with the given status codes in order,
+// repeating the last one, and records the file part of every upload
+type uploadServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	delay    time.Duration
+	parts    []uploadedPart
+}
+
+func newUploadServer(t *testing.T, delay time.Duration, statuses ...int) *uploadServer {
+	t.Helper()
+
+	s := &uploadServer{statuses: statuses, delay: delay}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading upload: %s", err)
+		}
+
+		part := uploadedPart{contentLength: r.ContentLength, bodyLength: len(body)}
+
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			t.Errorf("parsing content type: %s", err)
+		}
+		reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		if filePart, err := reader.NextPart(); err == nil {
+			content, _ := io.ReadAll(filePart)
+			part.filename = filePart.FileName()
+			part.contentType = filePart.Header.Get("Content-Type")
+			part.content = string(content)
+		} else {
+			t.Errorf("reading file part: %s", err)
+		}
+
+		s.mu.Lock()
+		attempt := len(s.parts)
+		s.parts = append(s.parts, part)
+		s.mu.Unlock()
+
+		time.Sleep(s.delay)
+
+		status := s.statuses[len(s.statuses)-1]
+		if attempt < len(s.statuses) {
+			status = s.statuses[attempt]
+		}
+		w.WriteHeader(status)
+		if status == http.StatusCreated {
+			_, _ = w.Write([]byte(`{"id":"file-1","status":"done"}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *uploadServer) uploads() []uploadedPart {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]uploadedPart(nil), s.parts...)
+}
+
+func TestUploadFile(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "catalog.csv")
+	content := "sku,price\nA-1,10\n"
+	if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		fileName        string
+		statuses        []int
+		wantUploads     int
+		wantContentType string
+		wantErr         bool
+	}{
+		{
+			name:            "upload",
+			fileName:        "catalog.csv",
+			statuses:        []int{201},
+			wantUploads:     1,
+			wantContentType: mime.TypeByExtension(".csv"),
+		},
+		{
+			name:            "retried upload sends the file again",
+			fileName:        "catalog.csv",
+			statuses:        []int{503, 201},
+			wantUploads:     2,
+			wantContentType: mime.TypeByExtension(".csv"),
+		},
+		{
+			name:            "unknown extension",
+			fileName:        "catalog.unknown-ext",
+			statuses:        []int{201},
+			wantUploads:     1,
+			wantContentType: "application/octet-stream",
+		},
+		{
+			name:            "name with quotes",
+			fileName:        `price "list".csv`,
+			statuses:        []int{201},
+			wantUploads:     1,
+			wantContentType: mime.TypeByExtension(".csv"),
+		},
+		{
+			name:            "rejected upload",
+			fileName:        "catalog.csv",
+			statuses:        []int{400},
+			wantUploads:     1,
+			wantContentType: mime.TypeByExtension(".csv"),
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newUploadServer(t, 0, tt.statuses...)
+			c := NewVapiClient(server.URL, "test-token", WithRetryConfig(testRetryConfig))
+
+			file, err := c.UploadFile(context.Background(), tt.fileName, source)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && file.ID != "file-1" {
+				t.Errorf("got file ID %q, want file-1", file.ID)
+			}
+
+			uploads := server.uploads()
+			if len(uploads) != tt.wantUploads {
+				t.Fatalf("got %d uploads, want %d", len(uploads), tt.wantUploads)
+			}
+
+			for i, upload := range uploads {
+				if upload.contentLength != int64(upload.bodyLength) {
+					t.Errorf("upload %d: sent Content-Length %d for a %d byte body", i+1, upload.contentLength, upload.bodyLength)
+				}
+				if upload.filename != tt.fileName {
+					t.Errorf("upload %d: got filename %q, want %q", i+1, upload.filename, tt.fileName)
+				}
+				if upload.contentType != tt.wantContentType {
+					t.Errorf("upload %d: got content type %q, want %q", i+1, upload.contentType, tt.wantContentType)
+				}
+				if upload.content != content {
+					t.Errorf("upload %d: got content %q, want %q", i+1, upload.content, content)
+				}
+			}
+		})
+	}
+}
+
+func TestUploadFileMissingSource(t *testing.T) {
+	server := newUploadServer(t, 0, 201)
+	c := NewVapiClient(server.URL, "test-token", WithRetryConfig(RetryConfig{}))
+
+	if _, err := c.UploadFile(context.Background(), "catalog.csv", filepath.Join(t.TempDir(), "missing.csv")); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if uploads := server.uploads(); len(uploads) != 0 {
+		t.Errorf("got %d uploads, want none", len(uploads))
+	}
+}
+
+func TestUploadFileIgnoresRequestTimeout(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "catalog.csv")
+	if err := os.WriteFile(source, []byte("sku,price\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The upload takes longer than the per-attempt timeout, which only bounds
+	// regular API requests
+	server := newUploadServer(t, 50*time.Millisecond, 201)
+	c := NewVapiClient(server.URL, "test-token", WithRetryConfig(RetryConfig{}), WithRequestTimeout(10*time.Millisecond))
+
+	if _, err := c.UploadFile(context.Background(), "catalog.csv", source); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
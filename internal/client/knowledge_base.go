@@ -0,0 +1,218 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// KnowledgeBase represents a Vapi knowledge base, either hosted on Trieve or
+// answered by the customer's own server. Which fields apply depends on Provider.
+type KnowledgeBase struct {
+	ID       string `json:"id,omitempty"`
+	Provider string `json:"provider,omitempty"`
+	Name     string `json:"name,omitempty"`
+
+	// Trieve
+	SearchPlan *KnowledgeBaseSearchPlan `json:"searchPlan,omitempty"`
+	CreatePlan *KnowledgeBaseCreatePlan `json:"createPlan,omitempty"`
+
+	// Custom knowledge base
+	Server *KnowledgeBaseServer `json:"server,omitempty"`
+
+	OrgID     string `json:"orgId,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the knowledge base, adding explicit nulls for NullFields
+func (k KnowledgeBase) MarshalJSON() ([]byte, error) {
+	type knowledgeBase KnowledgeBase
+	return marshalWithNullFields(knowledgeBase(k), k.NullFields)
+}
+
+// KnowledgeBaseSearchPlan represents how a Trieve knowledge base is searched
+type KnowledgeBaseSearchPlan struct {
+	SearchType      string   `json:"searchType"`
+	TopK            *int     `json:"topK,omitempty"`
+	RemoveStopWords *bool    `json:"removeStopWords,omitempty"`
+	ScoreThreshold  *float64 `json:"scoreThreshold,omitempty"`
+}
+
+// KnowledgeBaseCreatePlan represents how a Trieve knowledge base is built from files
+type KnowledgeBaseCreatePlan struct {
+	Type       string                   `json:"type"`
+	ChunkPlans []KnowledgeBaseChunkPlan `json:"chunkPlans,omitempty"`
+}
+
+// KnowledgeBaseChunkPlan represents a set of files and how they are split into chunks
+type KnowledgeBaseChunkPlan struct {
+	FileIDs              []string `json:"fileIds,omitempty"`
+	TargetSplitsPerChunk *int     `json:"targetSplitsPerChunk,omitempty"`
+	SplitDelimiters      []string `json:"splitDelimiters,omitempty"`
+	RebalanceChunks      *bool    `json:"rebalanceChunks,omitempty"`
+}
+
+// KnowledgeBaseServer represents the server a custom knowledge base sends queries to
+type KnowledgeBaseServer struct {
+	URL            string `json:"url"`
+	Secret         string `json:"secret,omitempty"`
+	TimeoutSeconds *int   `json:"timeoutSeconds,omitempty"`
+}
+
+// CreateKnowledgeBase creates a new knowledge base
+func (c *VapiClient) CreateKnowledgeBase(ctx context.Context, knowledgeBase *KnowledgeBase) (*KnowledgeBase, error) {
+	url := fmt.Sprintf("%s/knowledge-base", c.BaseURL)
+
+	jsonData, err := json.Marshal(knowledgeBase)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling knowledge base: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, body)
+	}
+
+	var createdKnowledgeBase KnowledgeBase
+	if err := json.Unmarshal(body, &createdKnowledgeBase); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &createdKnowledgeBase, nil
+}
+
+// GetKnowledgeBase retrieves a knowledge base by ID
+func (c *VapiClient) GetKnowledgeBase(ctx context.Context, id string) (*KnowledgeBase, error) {
+	url := fmt.Sprintf("%s/knowledge-base/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "knowledge base", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var knowledgeBase KnowledgeBase
+	if err := json.Unmarshal(body, &knowledgeBase); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &knowledgeBase, nil
+}
+
+// UpdateKnowledgeBase updates an existing knowledge base
+func (c *VapiClient) UpdateKnowledgeBase(ctx context.Context, id string, knowledgeBase *KnowledgeBase) (*KnowledgeBase, error) {
+	url := fmt.Sprintf("%s/knowledge-base/%s", c.BaseURL, id)
+
+	jsonData, err := json.Marshal(knowledgeBase)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling knowledge base: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "knowledge base", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var updatedKnowledgeBase KnowledgeBase
+	if err := json.Unmarshal(body, &updatedKnowledgeBase); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updatedKnowledgeBase, nil
+}
+
+// DeleteKnowledgeBase deletes a knowledge base by ID
+func (c *VapiClient) DeleteKnowledgeBase(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/knowledge-base/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "knowledge base", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
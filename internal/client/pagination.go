@@ -0,0 +1,249 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultListPageSize is the number of items requested per page when ListOptions.PageSize is unset
+const DefaultListPageSize = 100
+
+// ListOptions controls how list endpoints are paged
+type ListOptions struct {
+	// PageSize is the number of items requested per page. Defaults to DefaultListPageSize.
+	PageSize int
+	// MaxItems caps the number of items returned. Zero means no cap.
+	MaxItems int
+	// CreatedAfter only returns items created after this timestamp (createdAtGt)
+	CreatedAfter time.Time
+	// CreatedBefore only returns items created before this timestamp (createdAtLt)
+	CreatedBefore time.Time
+}
+
+// Iterator walks a paged Vapi listing one item at a time, fetching pages on demand.
+//
+//	it := c.IterateAssistants(client.ListOptions{})
+//	for it.Next(ctx) {
+//		assistant := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch     func(ctx context.Context, query url.Values) ([]T, error)
+	id        func(T) string
+	createdAt func(T) string
+	opts      ListOptions
+
+	page    []T
+	current T
+	cursor  string
+	// cursorExclusive pages past the cursor timestamp instead of including it
+	cursorExclusive bool
+	seen            map[string]bool
+	returned        int
+	lastPage        bool
+	err             error
+}
+
+func newIterator[T any](opts ListOptions, fetch func(context.Context, url.Values) ([]T, error), id, createdAt func(T) string) *Iterator[T] {
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultListPageSize
+	}
+
+	return &Iterator[T]{
+		fetch:     fetch,
+		id:        id,
+		createdAt: createdAt,
+		opts:      opts,
+		seen:      make(map[string]bool),
+	}
+}
+
+// Next advances to the next item, fetching another page when needed. It
+// returns false once the listing is exhausted, the cap is reached or a
+// request fails; check Err to tell these apart.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil || (it.opts.MaxItems > 0 && it.returned >= it.opts.MaxItems) {
+		return false
+	}
+
+	for len(it.page) == 0 {
+		if it.lastPage {
+			return false
+		}
+		if err := it.fetchPage(ctx); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	it.current = it.page[0]
+	it.page = it.page[1:]
+	it.returned++
+
+	return true
+}
+
+// Value returns the item Next advanced to
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// fetchPage requests the next page. Vapi returns items newest first, so each
+// page after the first asks for items created at or before the oldest item
+// seen so far; items already returned are skipped, which keeps items sharing
+// a timestamp across a page boundary from being lost or repeated. When a full
+// page holds nothing new, a page's worth of items share the cursor timestamp
+// and the next page steps past it. Vapi has no secondary cursor, so if even
+// more items share that timestamp they can't be reached and iteration fails
+// rather than silently leaving them out.
+func (it *Iterator[T]) fetchPage(ctx context.Context) error {
+	items, err := it.fetch(ctx, it.query(it.opts.PageSize))
+	if err != nil {
+		return err
+	}
+
+	// A short page is the last one
+	it.lastPage = len(items) < it.opts.PageSize
+
+	var oldest time.Time
+	fresh := 0
+	for _, item := range items {
+		id := it.id(item)
+		if it.seen[id] {
+			continue
+		}
+		it.seen[id] = true
+		it.page = append(it.page, item)
+		fresh++
+
+		created, err := it.parseCreatedAt(item)
+		if err != nil {
+			return err
+		}
+		if oldest.IsZero() || created.Before(oldest) {
+			oldest = created
+			it.cursor = it.createdAt(item)
+		}
+	}
+
+	if fresh > 0 || it.lastPage {
+		it.cursorExclusive = false
+		return nil
+	}
+
+	// Items before an exclusive cursor can't have been seen, so nothing new
+	// there means the listing is exhausted
+	if it.cursorExclusive {
+		it.lastPage = true
+		return nil
+	}
+
+	// Ask for one more item to find out whether stepping past the cursor
+	// would skip items sharing its timestamp
+	probe, err := it.fetch(ctx, it.query(it.opts.PageSize+1))
+	if err != nil {
+		return err
+	}
+	if len(probe) > it.opts.PageSize {
+		next, err := it.parseCreatedAt(probe[it.opts.PageSize])
+		if err != nil {
+			return err
+		}
+		cursor, err := time.Parse(time.RFC3339Nano, it.cursor)
+		if err != nil {
+			return fmt.Errorf("error paging results: invalid cursor %q", it.cursor)
+		}
+		if next.Equal(cursor) {
+			return fmt.Errorf("error paging results: more than %d items share the creation timestamp %s, so they can't all be listed; increase the page size", it.opts.PageSize, it.cursor)
+		}
+	}
+
+	it.cursorExclusive = true
+
+	return nil
+}
+
+// query returns the filters for the next page of up to limit items
+func (it *Iterator[T]) query(limit int) url.Values {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	if !it.opts.CreatedAfter.IsZero() {
+		query.Set("createdAtGt", it.opts.CreatedAfter.UTC().Format(time.RFC3339Nano))
+	}
+	if !it.opts.CreatedBefore.IsZero() {
+		query.Set("createdAtLt", it.opts.CreatedBefore.UTC().Format(time.RFC3339Nano))
+	}
+	if it.cursor != "" && it.cursorExclusive {
+		query.Set("createdAtLt", it.cursor)
+	} else if it.cursor != "" {
+		query.Set("createdAtLe", it.cursor)
+	}
+
+	return query
+}
+
+// parseCreatedAt returns the creation time of item
+func (it *Iterator[T]) parseCreatedAt(item T) (time.Time, error) {
+	created, err := time.Parse(time.RFC3339Nano, it.createdAt(item))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error paging results: item %s has an invalid createdAt %q", it.id(item), it.createdAt(item))
+	}
+
+	return created, nil
+}
+
+// collect drains the iterator into a slice
+func collect[T any](ctx context.Context, it *Iterator[T]) ([]T, error) {
+	items := []T{}
+	for it.Next(ctx) {
+		items = append(items, it.Value())
+	}
+
+	return items, it.Err()
+}
+
+// listPage issues a GET for a single page of a listing and unmarshals it into out
+func (c *VapiClient) listPage(ctx context.Context, path string, query url.Values, out interface{}) error {
+	endpoint := fmt.Sprintf("%s%s?%s", c.BaseURL, path, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp, body)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return nil
+}
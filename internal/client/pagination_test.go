@@ -0,0 +1,329 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// listServer serves a fixed set of assistants the way Vapi pages listings:
+// newest first, filtered by the createdAt query parameters and cut at limit.
+// Items sharing a timestamp keep their order across requests.
+type listServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	queries []url.Values
+}
+
+func newListServer(t *testing.T, assistants []Assistant) *listServer {
+	t.Helper()
+
+	s := &listServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		s.mu.Lock()
+		s.queries = append(s.queries, query)
+		s.mu.Unlock()
+
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+
+		page := []Assistant{}
+		for _, assistant := range assistants {
+			if len(page) == limit {
+				break
+			}
+			if matchesCreatedAtFilters(t, assistant.CreatedAt, query) {
+				page = append(page, assistant)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *listServer) requests() []url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]url.Values(nil), s.queries...)
+}
+
+func matchesCreatedAtFilters(t *testing.T, createdAt string, query url.Values) bool {
+	t.Helper()
+
+	created := mustParseTime(t, createdAt)
+	filters := []struct {
+		param string
+		match func(created, bound time.Time) bool
+	}{
+		{"createdAtGt", func(c, b time.Time) bool { return c.After(b) }},
+		{"createdAtLt", func(c, b time.Time) bool { return c.Before(b) }},
+		{"createdAtLe", func(c, b time.Time) bool { return !c.After(b) }},
+	}
+
+	for _, filter := range filters {
+		if value := query.Get(filter.param); value != "" && !filter.match(created, mustParseTime(t, value)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		t.Fatalf("invalid timestamp %q: %s", value, err)
+	}
+
+	return parsed
+}
+
+// testAssistants returns assistants named after their index, newest first,
+// created at the given minutes past midnight
+func testAssistants(minutes ...int) []Assistant {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assistants := make([]Assistant, 0, len(minutes))
+	for i, minute := range minutes {
+		assistants = append(assistants, Assistant{
+			ID:        fmt.Sprintf("assistant-%d", i),
+			Name:      fmt.Sprintf("Assistant %d", i),
+			CreatedAt: base.Add(time.Duration(minute) * time.Minute).Format(time.RFC3339Nano),
+		})
+	}
+
+	return assistants
+}
+
+func assistantIDs(assistants []Assistant) []string {
+	ids := make([]string, 0, len(assistants))
+	for _, assistant := range assistants {
+		ids = append(ids, assistant.ID)
+	}
+
+	return ids
+}
+
+func newTestListClient(url string) *VapiClient {
+	return NewVapiClient(url, "test-token", WithRetryConfig(RetryConfig{}))
+}
+
+func TestListAssistantsPages(t *testing.T) {
+	tests := []struct {
+		name         string
+		minutes      []int
+		pageSize     int
+		wantRequests int
+	}{
+		{"single short page", []int{5, 4, 3}, 10, 1},
+		{"distinct timestamps with short last page", []int{9, 8, 7, 6, 5}, 2, 5},
+		{"distinct timestamps with full last page", []int{9, 8, 7, 6}, 2, 4},
+		{"timestamps shared across page boundaries", []int{9, 8, 8, 7, 7, 3}, 3, 4},
+		{"page of one timestamp after the first page", []int{9, 8, 8, 8, 7, 7, 3}, 3, 6},
+		{"page of one timestamp followed by older items", []int{9, 9, 8, 7}, 2, 5},
+		{"empty listing", []int{}, 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assistants := testAssistants(tt.minutes...)
+			server := newListServer(t, assistants)
+
+			got, err := newTestListClient(server.URL).ListAssistants(context.Background(), ListOptions{PageSize: tt.pageSize})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(assistantIDs(got), assistantIDs(assistants)) {
+				t.Errorf("got %v, want %v", assistantIDs(got), assistantIDs(assistants))
+			}
+			if requests := server.requests(); len(requests) != tt.wantRequests {
+				t.Errorf("got %d requests, want %d", len(requests), tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestListAssistantsCursor(t *testing.T) {
+	assistants := testAssistants(9, 8, 8, 7)
+	server := newListServer(t, assistants)
+
+	_, err := newTestListClient(server.URL).ListAssistants(context.Background(), ListOptions{PageSize: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	requests := server.requests()
+	if len(requests) != 3 {
+		t.Fatalf("got %d requests, want 3", len(requests))
+	}
+
+	if cursor := requests[0].Get("createdAtLe"); cursor != "" {
+		t.Errorf("first page sent createdAtLe=%q", cursor)
+	}
+
+	// The second page starts at the oldest timestamp of the first, which the
+	// last item of the first page shares with the next item
+	if cursor, want := requests[1].Get("createdAtLe"), assistants[2].CreatedAt; cursor != want {
+		t.Errorf("second page sent createdAtLe=%q, want %q", cursor, want)
+	}
+
+	for i, query := range requests {
+		if limit := query.Get("limit"); limit != "3" {
+			t.Errorf("request %d sent limit=%q, want 3", i+1, limit)
+		}
+	}
+}
+
+func TestListAssistantsPageOfOneTimestamp(t *testing.T) {
+	// With a page size of two, the third page holds only the two items
+	// created at minute 8. One more item is asked for to check none are left
+	// at that timestamp before paging past it.
+	assistants := testAssistants(9, 8, 8, 7)
+	server := newListServer(t, assistants)
+
+	got, err := newTestListClient(server.URL).ListAssistants(context.Background(), ListOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{"assistant-0", "assistant-1", "assistant-2", "assistant-3"}; !reflect.DeepEqual(assistantIDs(got), want) {
+		t.Errorf("got %v, want %v", assistantIDs(got), want)
+	}
+
+	requests := server.requests()
+	if len(requests) != 5 {
+		t.Fatalf("got %d requests, want 5", len(requests))
+	}
+	if limit, cursor := requests[3].Get("limit"), requests[3].Get("createdAtLe"); limit != "3" || cursor != assistants[1].CreatedAt {
+		t.Errorf("probe sent limit=%q createdAtLe=%q, want limit=3 createdAtLe=%q", limit, cursor, assistants[1].CreatedAt)
+	}
+	if cursor, want := requests[4].Get("createdAtLt"), assistants[1].CreatedAt; cursor != want {
+		t.Errorf("page after the shared timestamp sent createdAtLt=%q, want %q", cursor, want)
+	}
+}
+
+func TestListAssistantsMoreThanAPageShareATimestamp(t *testing.T) {
+	// With a page size of two, the third item created at minute 8 can't be
+	// reached with a createdAtLe cursor. Iteration must fail rather than
+	// skip it.
+	assistants := testAssistants(9, 8, 8, 8, 7)
+	server := newListServer(t, assistants)
+
+	_, err := newTestListClient(server.URL).ListAssistants(context.Background(), ListOptions{PageSize: 2})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if want := "more than 2 items share the creation timestamp " + assistants[1].CreatedAt; !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %q, want it to contain %q", err, want)
+	}
+
+	if requests := server.requests(); len(requests) != 4 {
+		t.Fatalf("got %d requests, want 4", len(requests))
+	}
+}
+
+func TestListAssistantsOptions(t *testing.T) {
+	assistants := testAssistants(9, 8, 7, 6, 5, 4)
+	server := newListServer(t, assistants)
+
+	after := time.Date(2024, 1, 1, 0, 4, 0, 0, time.UTC)
+	before := time.Date(2024, 1, 1, 0, 9, 0, 0, time.UTC)
+
+	got, err := newTestListClient(server.URL).ListAssistants(context.Background(), ListOptions{
+		PageSize:      2,
+		MaxItems:      3,
+		CreatedAfter:  after,
+		CreatedBefore: before,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{"assistant-1", "assistant-2", "assistant-3"}; !reflect.DeepEqual(assistantIDs(got), want) {
+		t.Errorf("got %v, want %v", assistantIDs(got), want)
+	}
+
+	for i, query := range server.requests() {
+		if gt := query.Get("createdAtGt"); gt != after.Format(time.RFC3339Nano) {
+			t.Errorf("request %d sent createdAtGt=%q", i+1, gt)
+		}
+		if lt := query.Get("createdAtLt"); lt != before.Format(time.RFC3339Nano) {
+			t.Errorf("request %d sent createdAtLt=%q", i+1, lt)
+		}
+	}
+
+	// The cap is reached on the second page, so no third page is requested
+	if requests := server.requests(); len(requests) != 2 {
+		t.Errorf("got %d requests, want 2", len(requests))
+	}
+}
+
+func TestListAssistantsInvalidCreatedAt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]Assistant{{ID: "assistant-0", CreatedAt: "yesterday"}})
+	}))
+	defer server.Close()
+
+	_, err := newTestListClient(server.URL).ListAssistants(context.Background(), ListOptions{})
+	if err == nil {
+		t.Fatal("expected an error for an invalid createdAt")
+	}
+}
+
+func TestListAssistantsErrorStopsIteration(t *testing.T) {
+	requests := 0
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		first := requests == 1
+		mu.Unlock()
+
+		if first {
+			_ = json.NewEncoder(w).Encode(testAssistants(9, 8))
+			return
+		}
+		http.Error(w, `{"message":"boom"}`, http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	it := newTestListClient(server.URL).IterateAssistants(ListOptions{PageSize: 2})
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+
+	if want := []string{"assistant-0", "assistant-1"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got %v, want %v", ids, want)
+	}
+	if it.Err() == nil {
+		t.Fatal("expected the failed page to be reported by Err")
+	}
+	if it.Next(context.Background()) {
+		t.Error("Next advanced after an error")
+	}
+}
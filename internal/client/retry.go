@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryConfig controls how failed requests are retried
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// MinWait is the backoff before the first retry. It doubles on every further retry.
+	MinWait time.Duration
+	// MaxWait caps a single backoff, including waits requested through Retry-After.
+	MaxWait time.Duration
+	// MaxElapsed caps the total time spent on a request including all retries. Zero means no limit.
+	MaxElapsed time.Duration
+}
+
+// DefaultRetryConfig is used by NewVapiClient unless overridden with WithRetryConfig
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries: 4,
+	MinWait:    1 * time.Second,
+	MaxWait:    30 * time.Second,
+	MaxElapsed: 5 * time.Minute,
+}
+
+// retryTransport is an http.RoundTripper that retries rate limited, unavailable
+// and failed requests with jittered exponential backoff. Requests that may have
+// been processed by the server are only retried for idempotent methods.
+type retryTransport struct {
+	next   http.RoundTripper
+	config RetryConfig
+	// attemptTimeout bounds each individual attempt, so a hung connection can be retried
+	attemptTimeout time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, cancel, err := t.prepareAttempt(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if cancel != nil {
+			if err != nil {
+				cancel()
+			} else {
+				resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+			}
+		}
+
+		if attempt >= t.config.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if t.config.MaxElapsed > 0 && time.Since(start)+wait > t.config.MaxElapsed {
+			return resp, err
+		}
+
+		if resp != nil {
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		fields := map[string]interface{}{
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["vapi_response_status"] = resp.StatusCode
+		}
+		tflog.Warn(req.Context(), "Retrying Vapi API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// prepareAttempt returns the request to send for the given attempt, with a fresh
+// body for retries and the per-attempt timeout applied
+func (t *retryTransport) prepareAttempt(req *http.Request, attempt int) (*http.Request, context.CancelFunc, error) {
+	attemptReq := req
+	if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		attemptReq = req.Clone(req.Context())
+		attemptReq.Body = body
+	}
+
+	if t.attemptTimeout <= 0 || withoutAttemptTimeout(req.Context()) {
+		return attemptReq, nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(attemptReq.Context(), t.attemptTimeout)
+	return attemptReq.WithContext(ctx), cancel, nil
+}
+
+// shouldRetry reports whether the outcome of an attempt is worth retrying
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// The request body can't be replayed
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		// The caller gave up, retrying would be pointless
+		if req.Context().Err() != nil || errors.Is(err, context.Canceled) {
+			return false
+		}
+		// Connection resets and per-attempt timeouts may have reached the server
+		return isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		// The request was rejected before it was processed
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header from the server takes precedence, capped at MaxWait like any other
+// backoff; if the server still rejects the early retry, the next one waits again.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, found := parseRetryAfter(resp.Header.Get("Retry-After")); found {
+			return min(retryAfter, t.config.MaxWait)
+		}
+	}
+
+	wait := t.config.MinWait << attempt
+	if wait <= 0 || wait > t.config.MaxWait {
+		wait = t.config.MaxWait
+	}
+
+	// Picking a random wait between half and the whole backoff spreads out
+	// requests from parallel resource operations
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// noAttemptTimeoutKey marks request contexts that are exempt from the per-attempt timeout
+type noAttemptTimeoutKey struct{}
+
+// contextWithoutAttemptTimeout exempts requests made with the returned context
+// from the per-attempt timeout. Used for uploads, whose duration depends on the
+// file size, so that only the caller's deadline bounds them.
+func contextWithoutAttemptTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noAttemptTimeoutKey{}, true)
+}
+
+// withoutAttemptTimeout reports whether ctx was returned by contextWithoutAttemptTimeout
+func withoutAttemptTimeout(ctx context.Context) bool {
+	exempt, _ := ctx.Value(noAttemptTimeoutKey{}).(bool)
+	return exempt
+}
+
+// isIdempotent reports whether repeating a request with the method has no additional effect
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// cancelOnCloseBody releases the per-attempt context once the response body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
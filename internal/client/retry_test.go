@@ -0,0 +1,372 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetryConfig retries quickly so tests don't sleep through real backoffs
+var testRetryConfig = RetryConfig{
+	MaxRetries: 3,
+	MinWait:    time.Millisecond,
+	MaxWait:    time.Millisecond,
+}
+
+// statusServer replies with the given status codes in order, repeating the last
+// one, and records the body of every request it receives
+type statusServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	bodies   []string
+}
+
+func newStatusServer(t *testing.T, statuses ...int) *statusServer {
+	t.Helper()
+
+	s := &statusServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		s.mu.Lock()
+		attempt := len(s.bodies)
+		s.bodies = append(s.bodies, string(body))
+		s.mu.Unlock()
+
+		status := s.statuses[len(s.statuses)-1]
+		if attempt < len(s.statuses) {
+			status = s.statuses[attempt]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *statusServer) attempts() int {
+	return len(s.requestBodies())
+}
+
+func (s *statusServer) requestBodies() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.bodies...)
+}
+
+func newTestRetryClient(config RetryConfig) *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{
+			next:   http.DefaultTransport,
+			config: config,
+		},
+	}
+}
+
+func TestRetryTransportStatusCodes(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		wantAttempts int
+		wantStatus   int
+	}{
+		{"success", http.MethodGet, []int{200}, 1, 200},
+		{"client error", http.MethodGet, []int{404}, 1, 404},
+		{"get unavailable", http.MethodGet, []int{503, 503, 200}, 3, 200},
+		{"get rate limited", http.MethodGet, []int{429, 200}, 2, 200},
+		{"get server error", http.MethodGet, []int{500, 502, 504, 200}, 4, 200},
+		{"get retries exhausted", http.MethodGet, []int{503}, 4, 503},
+		{"delete server error", http.MethodDelete, []int{504, 204}, 2, 204},
+		{"post rate limited", http.MethodPost, []int{429, 201}, 2, 201},
+		{"post unavailable", http.MethodPost, []int{503, 201}, 2, 201},
+		{"post server error", http.MethodPost, []int{500, 201}, 1, 500},
+		{"post bad gateway", http.MethodPost, []int{502, 201}, 1, 502},
+		{"patch gateway timeout", http.MethodPatch, []int{504, 200}, 1, 504},
+		{"patch unavailable", http.MethodPatch, []int{503, 200}, 2, 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStatusServer(t, tt.statuses...)
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader(`{"name":"test"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := newTestRetryClient(testRetryConfig).Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := server.attempts(); got != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportRewindsBody(t *testing.T) {
+	server := newStatusServer(t, 503, 429, 201)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := newTestRetryClient(testRetryConfig).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	bodies := server.requestBodies()
+	if len(bodies) != 3 {
+		t.Fatalf("got %d attempts, want 3", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"name":"test"}` {
+			t.Errorf("attempt %d sent body %q", i+1, body)
+		}
+	}
+}
+
+func TestRetryTransportBodyWithoutGetBody(t *testing.T) {
+	server := newStatusServer(t, 503, 201)
+
+	// NewRequest only sets GetBody for known in-memory readers
+	req, err := http.NewRequest(http.MethodPost, server.URL, io.NopCloser(strings.NewReader(`{"name":"test"}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := newTestRetryClient(testRetryConfig).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != 503 {
+		t.Errorf("got status %d, want 503", resp.StatusCode)
+	}
+	if got := server.attempts(); got != 1 {
+		t.Errorf("got %d attempts, want 1", got)
+	}
+}
+
+func TestRetryTransportRetryAfterBeyondMaxWait(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	resp, err := newTestRetryClient(testRetryConfig).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	// The 60s wait is capped at MaxWait, so every retry is still made
+	if got := attempts.Load(); got != 4 {
+		t.Errorf("got %d attempts, want 4", got)
+	}
+}
+
+func TestRetryTransportMaxElapsed(t *testing.T) {
+	server := newStatusServer(t, 503)
+
+	config := testRetryConfig
+	config.MinWait = 50 * time.Millisecond
+	config.MaxWait = 50 * time.Millisecond
+	config.MaxElapsed = 10 * time.Millisecond
+
+	resp, err := newTestRetryClient(config).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got := server.attempts(); got != 1 {
+		t.Errorf("got %d attempts, want 1", got)
+	}
+}
+
+// errorTransport fails the first failures round trips with err, then succeeds
+type errorTransport struct {
+	err      error
+	failures int
+	attempts int
+}
+
+func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attempts++
+	if t.attempts <= t.failures {
+		return nil, t.err
+	}
+
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+func TestRetryTransportErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		err          error
+		wantAttempts int
+	}{
+		{"get connection reset", http.MethodGet, errors.New("connection reset by peer"), 2},
+		{"put connection reset", http.MethodPut, errors.New("connection reset by peer"), 2},
+		{"post connection reset", http.MethodPost, errors.New("connection reset by peer"), 1},
+		{"patch attempt timeout", http.MethodPatch, context.DeadlineExceeded, 1},
+		{"get canceled", http.MethodGet, context.Canceled, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &errorTransport{err: tt.err, failures: 1}
+			transport := &retryTransport{next: next, config: testRetryConfig}
+
+			req, err := http.NewRequest(tt.method, "http://vapi.test/assistant", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, _ = transport.RoundTrip(req)
+
+			if next.attempts != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", next.attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportAttemptTimeout(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &retryTransport{
+			next:           http.DefaultTransport,
+			config:         testRetryConfig,
+			attemptTimeout: 50 * time.Millisecond,
+		},
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("got %d attempts, want 2", got)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{config: RetryConfig{
+		MinWait: time.Second,
+		MaxWait: 10 * time.Second,
+	}}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{3, 8 * time.Second},
+		{4, 10 * time.Second},
+		// Shifting this far overflows, the cap still applies
+		{70, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			if wait := transport.backoff(tt.attempt, nil); wait < tt.want/2 || wait > tt.want {
+				t.Fatalf("attempt %d: got wait %s, want between %s and %s", tt.attempt, wait, tt.want/2, tt.want)
+			}
+		}
+	}
+}
+
+func TestRetryTransportBackoffRetryAfter(t *testing.T) {
+	transport := &retryTransport{config: RetryConfig{
+		MinWait: time.Second,
+		MaxWait: 10 * time.Second,
+	}}
+
+	tests := []struct {
+		retryAfter string
+		wantWait   time.Duration
+	}{
+		{"3", 3 * time.Second},
+		{"10", 10 * time.Second},
+		{"60", 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{tt.retryAfter}}}
+
+		if wait := transport.backoff(0, resp); wait != tt.wantWait {
+			t.Errorf("Retry-After %q: got %s, want %s", tt.retryAfter, wait, tt.wantWait)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		wantMin   time.Duration
+		wantMax   time.Duration
+		wantFound bool
+	}{
+		{"empty", "", 0, 0, false},
+		{"seconds", "120", 120 * time.Second, 120 * time.Second, true},
+		{"zero seconds", "0", 0, 0, true},
+		{"negative seconds", "-5", 0, 0, false},
+		{"garbage", "soon", 0, 0, false},
+		// HTTP dates have second precision, so allow for truncation and test run time
+		{"future date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), time.Hour - 2*time.Second, time.Hour, true},
+		{"past date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, found := parseRetryAfter(tt.value)
+			if found != tt.wantFound {
+				t.Fatalf("got found %t, want %t", found, tt.wantFound)
+			}
+			if wait < tt.wantMin || wait > tt.wantMax {
+				t.Errorf("got wait %s, want between %s and %s", wait, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SipTrunkProvider is the credential provider of bring-your-own SIP trunks
+const SipTrunkProvider = "byo-sip-trunk"
+
+// SipTrunk represents a bring-your-own SIP trunk, a credential that routes
+// calls for BYO phone numbers through the customer's own SIP gateways
+type SipTrunk struct {
+	ID                         string                      `json:"id,omitempty"`
+	Provider                   string                      `json:"provider,omitempty"`
+	Name                       string                      `json:"name,omitempty"`
+	Gateways                   []SipTrunkGateway           `json:"gateways,omitempty"`
+	OutboundAuthenticationPlan *SipTrunkAuthenticationPlan `json:"outboundAuthenticationPlan,omitempty"`
+	OutboundLeadingPlusEnabled *bool                       `json:"outboundLeadingPlusEnabled,omitempty"`
+	TechPrefix                 string                      `json:"techPrefix,omitempty"`
+	SipDiversionHeader         string                      `json:"sipDiversionHeader,omitempty"`
+	OrgID                      string                      `json:"orgId,omitempty"`
+	CreatedAt                  string                      `json:"createdAt,omitempty"`
+	UpdatedAt                  string                      `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the SIP trunk, adding explicit nulls for NullFields
+func (t SipTrunk) MarshalJSON() ([]byte, error) {
+	type sipTrunk SipTrunk
+	return marshalWithNullFields(sipTrunk(t), t.NullFields)
+}
+
+// SipTrunkGateway represents a SIP gateway calls are exchanged with
+type SipTrunkGateway struct {
+	IP                 string `json:"ip"`
+	Port               *int   `json:"port,omitempty"`
+	Netmask            *int   `json:"netmask,omitempty"`
+	InboundEnabled     *bool  `json:"inboundEnabled,omitempty"`
+	OutboundEnabled    *bool  `json:"outboundEnabled,omitempty"`
+	OutboundProtocol   string `json:"outboundProtocol,omitempty"`
+	OptionsPingEnabled *bool  `json:"optionsPingEnabled,omitempty"`
+}
+
+// SipTrunkAuthenticationPlan represents the digest credentials sent on outbound calls
+type SipTrunkAuthenticationPlan struct {
+	AuthUsername string `json:"authUsername,omitempty"`
+	AuthPassword string `json:"authPassword,omitempty"`
+}
+
+// CreateSipTrunk creates a new SIP trunk credential
+func (c *VapiClient) CreateSipTrunk(ctx context.Context, sipTrunk *SipTrunk) (*SipTrunk, error) {
+	url := fmt.Sprintf("%s/credential", c.BaseURL)
+
+	jsonData, err := json.Marshal(sipTrunk)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling SIP trunk: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, body)
+	}
+
+	var createdSipTrunk SipTrunk
+	if err := json.Unmarshal(body, &createdSipTrunk); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &createdSipTrunk, nil
+}
+
+// GetSipTrunk retrieves a SIP trunk credential by ID
+func (c *VapiClient) GetSipTrunk(ctx context.Context, id string) (*SipTrunk, error) {
+	url := fmt.Sprintf("%s/credential/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "SIP trunk", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var sipTrunk SipTrunk
+	if err := json.Unmarshal(body, &sipTrunk); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	if sipTrunk.Provider != SipTrunkProvider {
+		return nil, fmt.Errorf("credential %s is a %q credential, not a SIP trunk", id, sipTrunk.Provider)
+	}
+
+	return &sipTrunk, nil
+}
+
+// UpdateSipTrunk updates an existing SIP trunk credential
+func (c *VapiClient) UpdateSipTrunk(ctx context.Context, id string, sipTrunk *SipTrunk) (*SipTrunk, error) {
+	url := fmt.Sprintf("%s/credential/%s", c.BaseURL, id)
+
+	jsonData, err := json.Marshal(sipTrunk)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling SIP trunk: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "SIP trunk", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var updatedSipTrunk SipTrunk
+	if err := json.Unmarshal(body, &updatedSipTrunk); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updatedSipTrunk, nil
+}
+
+// DeleteSipTrunk deletes a SIP trunk credential by ID
+func (c *VapiClient) DeleteSipTrunk(ctx context.Context, id string) error {
+	return c.DeleteCredential(ctx, id)
+}
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Squad represents a Vapi squad of assistants that can hand calls off to each other
+type Squad struct {
+	ID        string        `json:"id,omitempty"`
+	Name      string        `json:"name,omitempty"`
+	Members   []SquadMember `json:"members"`
+	CreatedAt string        `json:"createdAt,omitempty"`
+	UpdatedAt string        `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the squad, adding explicit nulls for NullFields
+func (s Squad) MarshalJSON() ([]byte, error) {
+	type squad Squad
+	return marshalWithNullFields(squad(s), s.NullFields)
+}
+
+// SquadMember represents a single assistant within a squad
+type SquadMember struct {
+	AssistantID           string                   `json:"assistantId,omitempty"`
+	AssistantOverrides    map[string]interface{}   `json:"assistantOverrides,omitempty"`
+	AssistantDestinations []SquadMemberDestination `json:"assistantDestinations,omitempty"`
+}
+
+// SquadMemberDestination represents a transfer destination from one squad member to another
+type SquadMemberDestination struct {
+	Type          string `json:"type"`
+	AssistantName string `json:"assistantName"`
+	Message       string `json:"message,omitempty"`
+	Description   string `json:"description,omitempty"`
+	TransferMode  string `json:"transferMode,omitempty"`
+}
+
+// CreateSquad creates a new squad
+func (c *VapiClient) CreateSquad(ctx context.Context, squad *Squad) (*Squad, error) {
+	url := fmt.Sprintf("%s/squad", c.BaseURL)
+
+	jsonData, err := json.Marshal(squad)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling squad: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, body)
+	}
+
+	var createdSquad Squad
+	if err := json.Unmarshal(body, &createdSquad); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &createdSquad, nil
+}
+
+// GetSquad retrieves a squad by ID
+func (c *VapiClient) GetSquad(ctx context.Context, id string) (*Squad, error) {
+	url := fmt.Sprintf("%s/squad/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "squad", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var squad Squad
+	if err := json.Unmarshal(body, &squad); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &squad, nil
+}
+
+// UpdateSquad updates an existing squad
+func (c *VapiClient) UpdateSquad(ctx context.Context, id string, squad *Squad) (*Squad, error) {
+	url := fmt.Sprintf("%s/squad/%s", c.BaseURL, id)
+
+	jsonData, err := json.Marshal(squad)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling squad: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "squad", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var updatedSquad Squad
+	if err := json.Unmarshal(body, &updatedSquad); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updatedSquad, nil
+}
+
+// DeleteSquad deletes a squad by ID
+func (c *VapiClient) DeleteSquad(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/squad/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "squad", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// ListSquads retrieves all squads
+func (c *VapiClient) ListSquads(ctx context.Context) ([]Squad, error) {
+	url := fmt.Sprintf("%s/squad", c.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var squads []Squad
+	if err := json.Unmarshal(body, &squads); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return squads, nil
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Tool represents a Vapi tool that an assistant's model can call during a conversation
+type Tool struct {
+	ID             string              `json:"id,omitempty"`
+	Type           string              `json:"type,omitempty"`
+	Async          *bool               `json:"async,omitempty"`
+	Function       *ToolFunction       `json:"function,omitempty"`
+	Server         *ToolServer         `json:"server,omitempty"`
+	Messages       []ToolMessage       `json:"messages,omitempty"`
+	Destinations   []ToolDestination   `json:"destinations,omitempty"`
+	KnowledgeBases []ToolKnowledgeBase `json:"knowledgeBases,omitempty"`
+	CreatedAt      string              `json:"createdAt,omitempty"`
+	UpdatedAt      string              `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the tool, adding explicit nulls for NullFields
+func (t Tool) MarshalJSON() ([]byte, error) {
+	type tool Tool
+	return marshalWithNullFields(tool(t), t.NullFields)
+}
+
+// ToolFunction represents the function definition the model sees for a tool
+type ToolFunction struct {
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+	Strict      *bool                  `json:"strict,omitempty"`
+}
+
+// ToolServer represents the server that receives a tool call
+type ToolServer struct {
+	URL            string `json:"url"`
+	Secret         string `json:"secret,omitempty"`
+	TimeoutSeconds *int   `json:"timeoutSeconds,omitempty"`
+}
+
+// ToolMessage represents a message spoken while a tool call is in progress
+type ToolMessage struct {
+	Type    string `json:"type"`
+	Content string `json:"content,omitempty"`
+}
+
+// ToolDestination represents a destination a transferCall tool can transfer to
+type ToolDestination struct {
+	Type          string `json:"type"`
+	Number        string `json:"number,omitempty"`
+	SipURI        string `json:"sipUri,omitempty"`
+	AssistantName string `json:"assistantName,omitempty"`
+	Message       string `json:"message,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Extension     string `json:"extension,omitempty"`
+	CallerID      string `json:"callerId,omitempty"`
+}
+
+// ToolKnowledgeBase represents a knowledge base searched by a query tool
+type ToolKnowledgeBase struct {
+	Provider    string   `json:"provider"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	FileIDs     []string `json:"fileIds"`
+}
+
+// CreateTool creates a new tool
+func (c *VapiClient) CreateTool(ctx context.Context, tool *Tool) (*Tool, error) {
+	url := fmt.Sprintf("%s/tool", c.BaseURL)
+
+	jsonData, err := json.Marshal(tool)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling tool: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, body)
+	}
+
+	var createdTool Tool
+	if err := json.Unmarshal(body, &createdTool); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &createdTool, nil
+}
+
+// GetTool retrieves a tool by ID
+func (c *VapiClient) GetTool(ctx context.Context, id string) (*Tool, error) {
+	url := fmt.Sprintf("%s/tool/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "tool", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var tool Tool
+	if err := json.Unmarshal(body, &tool); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &tool, nil
+}
+
+// UpdateTool updates an existing tool
+func (c *VapiClient) UpdateTool(ctx context.Context, id string, tool *Tool) (*Tool, error) {
+	url := fmt.Sprintf("%s/tool/%s", c.BaseURL, id)
+
+	jsonData, err := json.Marshal(tool)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling tool: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "tool", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var updatedTool Tool
+	if err := json.Unmarshal(body, &updatedTool); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updatedTool, nil
+}
+
+// DeleteTool deletes a tool by ID
+func (c *VapiClient) DeleteTool(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/tool/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "tool", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// ListTools retrieves all tools
+func (c *VapiClient) ListTools(ctx context.Context) ([]Tool, error) {
+	url := fmt.Sprintf("%s/tool", c.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var tools []Tool
+	if err := json.Unmarshal(body, &tools); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return tools, nil
+}
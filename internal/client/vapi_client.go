@@ -2,11 +2,16 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // VapiClient represents a client for the Vapi API
@@ -16,13 +21,49 @@ type VapiClient struct {
 	HTTPClient *http.Client
 }
 
-// NewVapiClient creates a new Vapi API client
-func NewVapiClient(baseURL, token string) *VapiClient {
+// ClientOption customizes a VapiClient created with NewVapiClient
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	retry          RetryConfig
+	attemptTimeout time.Duration
+}
+
+// WithRetryConfig overrides DefaultRetryConfig
+func WithRetryConfig(config RetryConfig) ClientOption {
+	return func(o *clientOptions) {
+		o.retry = config
+	}
+}
+
+// WithRequestTimeout sets how long a single request attempt may take. Defaults to 30 seconds.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.attemptTimeout = timeout
+	}
+}
+
+// NewVapiClient creates a new Vapi API client. Rate limited and transiently
+// failing requests are retried according to DefaultRetryConfig.
+func NewVapiClient(baseURL, token string, opts ...ClientOption) *VapiClient {
+	options := clientOptions{
+		retry:          DefaultRetryConfig,
+		attemptTimeout: 30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	return &VapiClient{
 		BaseURL: baseURL,
 		Token:   token,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			// The timeout applies to each attempt, so it can't cut retries short
+			Transport: &retryTransport{
+				next:           http.DefaultTransport,
+				config:         options.retry,
+				attemptTimeout: options.attemptTimeout,
+			},
 		},
 	}
 }
@@ -34,6 +75,7 @@ type Assistant struct {
 	FirstMessage                 string                   `json:"firstMessage,omitempty"`
 	Model                        *AssistantModel          `json:"model,omitempty"`
 	Voice                        *AssistantVoice          `json:"voice,omitempty"`
+	Transcriber                  *AssistantTranscriber    `json:"transcriber,omitempty"`
 	ClientMessages               []string                 `json:"clientMessages,omitempty"`
 	ServerMessages               []string                 `json:"serverMessages,omitempty"`
 	SilenceTimeoutSeconds        *int                     `json:"silenceTimeoutSeconds,omitempty"`
@@ -43,21 +85,40 @@ type Assistant struct {
 	ModelOutputInMessagesEnabled *bool                    `json:"modelOutputInMessagesEnabled,omitempty"`
 	ServerURL                    string                   `json:"serverUrl,omitempty"`
 	TransportConfigurations      []map[string]interface{} `json:"transportConfigurations,omitempty"`
+	OrgID                        string                   `json:"orgId,omitempty"`
 	CreatedAt                    string                   `json:"createdAt,omitempty"`
 	UpdatedAt                    string                   `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the assistant, adding explicit nulls for NullFields
+func (a Assistant) MarshalJSON() ([]byte, error) {
+	type assistant Assistant
+	return marshalWithNullFields(assistant(a), a.NullFields)
 }
 
 // AssistantModel represents the model configuration for an assistant
 type AssistantModel struct {
-	Provider                  string   `json:"provider"`
-	Model                     string   `json:"model"`
-	SystemPrompt              string   `json:"systemPrompt,omitempty"`
-	Temperature               *float64 `json:"temperature,omitempty"`
-	MaxTokens                 *int     `json:"maxTokens,omitempty"`
-	EmotionRecognitionEnabled *bool    `json:"emotionRecognitionEnabled,omitempty"`
-	NumFastTurns              *int     `json:"numFastTurns,omitempty"`
-	ToolIds                   []string `json:"toolIds,omitempty"`
-	FunctionIds               []string `json:"functionIds,omitempty"`
+	Provider                  string                  `json:"provider"`
+	Model                     string                  `json:"model"`
+	SystemPrompt              string                  `json:"systemPrompt,omitempty"`
+	Temperature               *float64                `json:"temperature,omitempty"`
+	MaxTokens                 *int                    `json:"maxTokens,omitempty"`
+	EmotionRecognitionEnabled *bool                   `json:"emotionRecognitionEnabled,omitempty"`
+	NumFastTurns              *int                    `json:"numFastTurns,omitempty"`
+	ToolIds                   []string                `json:"toolIds,omitempty"`
+	FunctionIds               []string                `json:"functionIds,omitempty"`
+	Messages                  []AssistantModelMessage `json:"messages,omitempty"`
+	Tools                     []Tool                  `json:"tools,omitempty"`
+	KnowledgeBaseID           string                  `json:"knowledgeBaseId,omitempty"`
+}
+
+// AssistantModelMessage represents a message that primes the model, such as a system prompt
+type AssistantModelMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
 }
 
 // AssistantVoice represents the voice configuration for an assistant
@@ -71,27 +132,147 @@ type AssistantVoice struct {
 	UseSpeakerBoost *bool    `json:"useSpeakerBoost,omitempty"`
 }
 
-// PhoneNumber represents a Vapi phone number
+// AssistantTranscriber represents the speech-to-text configuration for an
+// assistant. Not every provider supports every field.
+type AssistantTranscriber struct {
+	Provider                     string   `json:"provider"`
+	Model                        string   `json:"model,omitempty"`
+	Language                     string   `json:"language,omitempty"`
+	Keywords                     []string `json:"keywords,omitempty"`
+	Keyterm                      []string `json:"keyterm,omitempty"`
+	SmartFormat                  *bool    `json:"smartFormat,omitempty"`
+	Endpointing                  *int     `json:"endpointing,omitempty"`
+	ConfidenceThreshold          *float64 `json:"confidenceThreshold,omitempty"`
+	EndOfTurnConfidenceThreshold *float64 `json:"endOfTurnConfidenceThreshold,omitempty"`
+}
+
+// PhoneNumber represents a Vapi phone number. Which carrier fields apply
+// depends on Provider: twilio, vonage, telnyx, byo-phone-number or vapi.
 type PhoneNumber struct {
-	ID                string `json:"id,omitempty"`
-	Number            string `json:"number,omitempty"`
-	Name              string `json:"name,omitempty"`
-	AssistantID       string `json:"assistantId,omitempty"`
-	SquadID           string `json:"squadId,omitempty"`
-	ServerURL         string `json:"serverUrl,omitempty"`
-	ServerURLSecret   string `json:"serverUrlSecret,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	TwilioAccountSid  string `json:"twilioAccountSid,omitempty"`
-	TwilioAuthToken   string `json:"twilioAuthToken,omitempty"`
-	VonageAPIKey      string `json:"vonageApiKey,omitempty"`
-	VonageAPISecret   string `json:"vonageApiSecret,omitempty"`
-	VonageApplicationID string `json:"vonageApplicationId,omitempty"`
-	CreatedAt         string `json:"createdAt,omitempty"`
-	UpdatedAt         string `json:"updatedAt,omitempty"`
+	ID                     string `json:"id,omitempty"`
+	Number                 string `json:"number,omitempty"`
+	Name                   string `json:"name,omitempty"`
+	AssistantID            string `json:"assistantId,omitempty"`
+	SquadID                string `json:"squadId,omitempty"`
+	WorkflowID             string `json:"workflowId,omitempty"`
+	ServerURL              string `json:"serverUrl,omitempty"`
+	ServerURLSecret        string `json:"serverUrlSecret,omitempty"`
+	Provider               string `json:"provider,omitempty"`
+	CredentialID           string `json:"credentialId,omitempty"`
+	TwilioAccountSid       string `json:"twilioAccountSid,omitempty"`
+	TwilioAuthToken        string `json:"twilioAuthToken,omitempty"`
+	TwilioAPIKey           string `json:"twilioApiKey,omitempty"`
+	TwilioAPISecret        string `json:"twilioApiSecret,omitempty"`
+	VonageAPIKey           string `json:"vonageApiKey,omitempty"`
+	VonageAPISecret        string `json:"vonageApiSecret,omitempty"`
+	VonageApplicationID    string `json:"vonageApplicationId,omitempty"`
+	TelnyxAPIKey           string `json:"telnyxApiKey,omitempty"`
+	NumberE164CheckEnabled *bool  `json:"numberE164CheckEnabled,omitempty"`
+	NumberDesiredAreaCode  string `json:"numberDesiredAreaCode,omitempty"`
+	SipURI                 string `json:"sipUri,omitempty"`
+	Status                 string `json:"status,omitempty"`
+	OrgID                  string `json:"orgId,omitempty"`
+	CreatedAt              string `json:"createdAt,omitempty"`
+	UpdatedAt              string `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the phone number, adding explicit nulls for NullFields
+func (p PhoneNumber) MarshalJSON() ([]byte, error) {
+	type phoneNumber PhoneNumber
+	return marshalWithNullFields(phoneNumber(p), p.NullFields)
+}
+
+// do sends a request, logging it with per-request fields so that retries and
+// failures can be matched to the resource operation in TF_LOG output
+func (c *VapiClient) do(req *http.Request) (*http.Response, error) {
+	ctx := tflog.SetField(req.Context(), "vapi_request_method", req.Method)
+	ctx = tflog.SetField(ctx, "vapi_request_url", req.URL.String())
+
+	tflog.Debug(ctx, "Sending Vapi API request")
+
+	start := time.Now()
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		tflog.Debug(ctx, "Vapi API request failed", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Received Vapi API response", map[string]interface{}{
+		"vapi_response_status": resp.StatusCode,
+		"vapi_duration_ms":     time.Since(start).Milliseconds(),
+	})
+
+	return resp, nil
+}
+
+// marshalWithNullFields encodes v and sets each of nullFields to an explicit null.
+//
+// Update payloads omit empty fields, and Vapi leaves omitted fields unchanged
+// on PATCH, so an attribute removed from the configuration would otherwise keep
+// its previous value. Payload types carry a NullFields list, filled in by the
+// provider from the prior state, and call this from their MarshalJSON to clear
+// those fields instead. Entries are JSON field names, or dot-separated paths
+// such as "server.timeoutSeconds" for fields of nested objects.
+func marshalWithNullFields(v interface{}, nullFields []string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(nullFields) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for _, field := range nullFields {
+		if err := setNullField(fields, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// setNullField sets the field at a dot-separated path, such as
+// "function.description", to null. Nested fields are only cleared inside
+// objects that are part of the payload.
+func setNullField(fields map[string]json.RawMessage, field string) error {
+	name, rest, nested := strings.Cut(field, ".")
+	if !nested {
+		fields[name] = json.RawMessage("null")
+		return nil
+	}
+
+	raw, ok := fields[name]
+	if !ok || string(raw) == "null" {
+		return nil
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return err
+	}
+
+	if err := setNullField(object, rest); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	fields[name] = encoded
+
+	return nil
 }
 
 // CreateAssistant creates a new assistant
-func (c *VapiClient) CreateAssistant(assistant *Assistant) (*Assistant, error) {
+func (c *VapiClient) CreateAssistant(ctx context.Context, assistant *Assistant) (*Assistant, error) {
 	url := fmt.Sprintf("%s/assistant", c.BaseURL)
 
 	jsonData, err := json.Marshal(assistant)
@@ -99,7 +280,7 @@ func (c *VapiClient) CreateAssistant(assistant *Assistant) (*Assistant, error) {
 		return nil, fmt.Errorf("error marshaling assistant: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -107,7 +288,7 @@ func (c *VapiClient) CreateAssistant(assistant *Assistant) (*Assistant, error) {
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -119,7 +300,7 @@ func (c *VapiClient) CreateAssistant(assistant *Assistant) (*Assistant, error) {
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, body)
 	}
 
 	var createdAssistant Assistant
@@ -131,17 +312,17 @@ func (c *VapiClient) CreateAssistant(assistant *Assistant) (*Assistant, error) {
 }
 
 // GetAssistant retrieves an assistant by ID
-func (c *VapiClient) GetAssistant(id string) (*Assistant, error) {
+func (c *VapiClient) GetAssistant(ctx context.Context, id string) (*Assistant, error) {
 	url := fmt.Sprintf("%s/assistant/%s", c.BaseURL, id)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -153,11 +334,11 @@ func (c *VapiClient) GetAssistant(id string) (*Assistant, error) {
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("assistant not found")
+		return nil, &NotFoundError{Resource: "assistant", ID: id}
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, body)
 	}
 
 	var assistant Assistant
@@ -169,7 +350,7 @@ func (c *VapiClient) GetAssistant(id string) (*Assistant, error) {
 }
 
 // UpdateAssistant updates an existing assistant
-func (c *VapiClient) UpdateAssistant(id string, assistant *Assistant) (*Assistant, error) {
+func (c *VapiClient) UpdateAssistant(ctx context.Context, id string, assistant *Assistant) (*Assistant, error) {
 	url := fmt.Sprintf("%s/assistant/%s", c.BaseURL, id)
 
 	jsonData, err := json.Marshal(assistant)
@@ -177,7 +358,7 @@ func (c *VapiClient) UpdateAssistant(id string, assistant *Assistant) (*Assistan
 		return nil, fmt.Errorf("error marshaling assistant: %w", err)
 	}
 
-	req, err := http.NewRequest("PATCH", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -185,7 +366,7 @@ func (c *VapiClient) UpdateAssistant(id string, assistant *Assistant) (*Assistan
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -197,11 +378,11 @@ func (c *VapiClient) UpdateAssistant(id string, assistant *Assistant) (*Assistan
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("assistant not found")
+		return nil, &NotFoundError{Resource: "assistant", ID: id}
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, body)
 	}
 
 	var updatedAssistant Assistant
@@ -213,70 +394,55 @@ func (c *VapiClient) UpdateAssistant(id string, assistant *Assistant) (*Assistan
 }
 
 // DeleteAssistant deletes an assistant by ID
-func (c *VapiClient) DeleteAssistant(id string) error {
+func (c *VapiClient) DeleteAssistant(ctx context.Context, id string) error {
 	url := fmt.Sprintf("%s/assistant/%s", c.BaseURL, id)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return fmt.Errorf("error making request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("assistant not found")
+		return &NotFoundError{Resource: "assistant", ID: id}
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return newAPIError(resp, body)
 	}
 
 	return nil
 }
 
-// ListAssistants retrieves all assistants
-func (c *VapiClient) ListAssistants() ([]Assistant, error) {
-	url := fmt.Sprintf("%s/assistant", c.BaseURL)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
-	}
-
-	var assistants []Assistant
-	if err := json.Unmarshal(body, &assistants); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
+// IterateAssistants pages through all assistants, newest first
+func (c *VapiClient) IterateAssistants(opts ListOptions) *Iterator[Assistant] {
+	return newIterator(opts,
+		func(ctx context.Context, query url.Values) ([]Assistant, error) {
+			var page []Assistant
+			err := c.listPage(ctx, "/assistant", query, &page)
+			return page, err
+		},
+		func(item Assistant) string { return item.ID },
+		func(item Assistant) string { return item.CreatedAt },
+	)
+}
 
-	return assistants, nil
+// ListAssistants retrieves all assistants, following pagination until the listing is
+// exhausted or opts.MaxItems is reached
+func (c *VapiClient) ListAssistants(ctx context.Context, opts ListOptions) ([]Assistant, error) {
+	return collect(ctx, c.IterateAssistants(opts))
 }
 
 // CreatePhoneNumber creates a new phone number
-func (c *VapiClient) CreatePhoneNumber(phoneNumber *PhoneNumber) (*PhoneNumber, error) {
+func (c *VapiClient) CreatePhoneNumber(ctx context.Context, phoneNumber *PhoneNumber) (*PhoneNumber, error) {
 	url := fmt.Sprintf("%s/phone-number", c.BaseURL)
 
 	jsonData, err := json.Marshal(phoneNumber)
@@ -284,7 +450,7 @@ func (c *VapiClient) CreatePhoneNumber(phoneNumber *PhoneNumber) (*PhoneNumber,
 		return nil, fmt.Errorf("error marshaling phone number: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -292,7 +458,7 @@ func (c *VapiClient) CreatePhoneNumber(phoneNumber *PhoneNumber) (*PhoneNumber,
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -304,7 +470,7 @@ func (c *VapiClient) CreatePhoneNumber(phoneNumber *PhoneNumber) (*PhoneNumber,
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, body)
 	}
 
 	var createdPhoneNumber PhoneNumber
@@ -316,17 +482,17 @@ func (c *VapiClient) CreatePhoneNumber(phoneNumber *PhoneNumber) (*PhoneNumber,
 }
 
 // GetPhoneNumber retrieves a phone number by ID
-func (c *VapiClient) GetPhoneNumber(id string) (*PhoneNumber, error) {
+func (c *VapiClient) GetPhoneNumber(ctx context.Context, id string) (*PhoneNumber, error) {
 	url := fmt.Sprintf("%s/phone-number/%s", c.BaseURL, id)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -338,11 +504,11 @@ func (c *VapiClient) GetPhoneNumber(id string) (*PhoneNumber, error) {
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("phone number not found")
+		return nil, &NotFoundError{Resource: "phone number", ID: id}
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, body)
 	}
 
 	var phoneNumber PhoneNumber
@@ -354,7 +520,7 @@ func (c *VapiClient) GetPhoneNumber(id string) (*PhoneNumber, error) {
 }
 
 // UpdatePhoneNumber updates an existing phone number
-func (c *VapiClient) UpdatePhoneNumber(id string, phoneNumber *PhoneNumber) (*PhoneNumber, error) {
+func (c *VapiClient) UpdatePhoneNumber(ctx context.Context, id string, phoneNumber *PhoneNumber) (*PhoneNumber, error) {
 	url := fmt.Sprintf("%s/phone-number/%s", c.BaseURL, id)
 
 	jsonData, err := json.Marshal(phoneNumber)
@@ -362,7 +528,7 @@ func (c *VapiClient) UpdatePhoneNumber(id string, phoneNumber *PhoneNumber) (*Ph
 		return nil, fmt.Errorf("error marshaling phone number: %w", err)
 	}
 
-	req, err := http.NewRequest("PATCH", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -370,7 +536,7 @@ func (c *VapiClient) UpdatePhoneNumber(id string, phoneNumber *PhoneNumber) (*Ph
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -382,11 +548,11 @@ func (c *VapiClient) UpdatePhoneNumber(id string, phoneNumber *PhoneNumber) (*Ph
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("phone number not found")
+		return nil, &NotFoundError{Resource: "phone number", ID: id}
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, body)
 	}
 
 	var updatedPhoneNumber PhoneNumber
@@ -398,64 +564,49 @@ func (c *VapiClient) UpdatePhoneNumber(id string, phoneNumber *PhoneNumber) (*Ph
 }
 
 // DeletePhoneNumber deletes a phone number by ID
-func (c *VapiClient) DeletePhoneNumber(id string) error {
+func (c *VapiClient) DeletePhoneNumber(ctx context.Context, id string) error {
 	url := fmt.Sprintf("%s/phone-number/%s", c.BaseURL, id)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 
-	resp, err := c.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return fmt.Errorf("error making request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("phone number not found")
+		return &NotFoundError{Resource: "phone number", ID: id}
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return newAPIError(resp, body)
 	}
 
 	return nil
 }
 
-// ListPhoneNumbers retrieves all phone numbers
-func (c *VapiClient) ListPhoneNumbers() ([]PhoneNumber, error) {
-	url := fmt.Sprintf("%s/phone-number", c.BaseURL)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error making request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
-	}
-
-	var phoneNumbers []PhoneNumber
-	if err := json.Unmarshal(body, &phoneNumbers); err != nil {
-		return nil, fmt.Errorf("error unmarshaling response: %w", err)
-	}
+// IteratePhoneNumbers pages through all phone numbers, newest first
+func (c *VapiClient) IteratePhoneNumbers(opts ListOptions) *Iterator[PhoneNumber] {
+	return newIterator(opts,
+		func(ctx context.Context, query url.Values) ([]PhoneNumber, error) {
+			var page []PhoneNumber
+			err := c.listPage(ctx, "/phone-number", query, &page)
+			return page, err
+		},
+		func(item PhoneNumber) string { return item.ID },
+		func(item PhoneNumber) string { return item.CreatedAt },
+	)
+}
 
-	return phoneNumbers, nil
+// ListPhoneNumbers retrieves all phone numbers, following pagination until the listing is
+// exhausted or opts.MaxItems is reached
+func (c *VapiClient) ListPhoneNumbers(ctx context.Context, opts ListOptions) ([]PhoneNumber, error) {
+	return collect(ctx, c.IteratePhoneNumbers(opts))
 }
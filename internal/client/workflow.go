@@ -0,0 +1,221 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Workflow represents a Vapi workflow, a conversation flow of nodes connected by conditional edges
+type Workflow struct {
+	ID           string         `json:"id,omitempty"`
+	Name         string         `json:"name"`
+	Nodes        []WorkflowNode `json:"nodes"`
+	Edges        []WorkflowEdge `json:"edges"`
+	GlobalPrompt string         `json:"globalPrompt,omitempty"`
+	OrgID        string         `json:"orgId,omitempty"`
+	CreatedAt    string         `json:"createdAt,omitempty"`
+	UpdatedAt    string         `json:"updatedAt,omitempty"`
+
+	// NullFields are sent as explicit nulls, see marshalWithNullFields
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the workflow, adding explicit nulls for NullFields
+func (w Workflow) MarshalJSON() ([]byte, error) {
+	type workflow Workflow
+	return marshalWithNullFields(workflow(w), w.NullFields)
+}
+
+// WorkflowNode represents a step of a workflow. Conversation nodes talk to the
+// caller, tool nodes run a saved tool or an inline transferCall or endCall tool.
+type WorkflowNode struct {
+	Type           string                  `json:"type"`
+	Name           string                  `json:"name"`
+	IsStart        *bool                   `json:"isStart,omitempty"`
+	Prompt         string                  `json:"prompt,omitempty"`
+	MessagePlan    *WorkflowMessagePlan    `json:"messagePlan,omitempty"`
+	GlobalNodePlan *WorkflowGlobalNodePlan `json:"globalNodePlan,omitempty"`
+	ToolID         string                  `json:"toolId,omitempty"`
+	Tool           *Tool                   `json:"tool,omitempty"`
+}
+
+// WorkflowMessagePlan represents what a conversation node says when it is entered
+type WorkflowMessagePlan struct {
+	FirstMessage string `json:"firstMessage,omitempty"`
+}
+
+// WorkflowGlobalNodePlan represents a conversation node that can be entered from anywhere in the workflow
+type WorkflowGlobalNodePlan struct {
+	Enabled        bool   `json:"enabled"`
+	EnterCondition string `json:"enterCondition,omitempty"`
+}
+
+// WorkflowEdge represents a transition between two nodes, identified by name
+type WorkflowEdge struct {
+	From      string                 `json:"from"`
+	To        string                 `json:"to"`
+	Condition *WorkflowEdgeCondition `json:"condition,omitempty"`
+}
+
+// WorkflowEdgeCondition represents when an edge is taken, decided by the model
+// from a prompt or evaluated as a Liquid expression
+type WorkflowEdgeCondition struct {
+	Type   string `json:"type"`
+	Prompt string `json:"prompt,omitempty"`
+	Liquid string `json:"liquid,omitempty"`
+}
+
+// CreateWorkflow creates a new workflow
+func (c *VapiClient) CreateWorkflow(ctx context.Context, workflow *Workflow) (*Workflow, error) {
+	url := fmt.Sprintf("%s/workflow", c.BaseURL)
+
+	jsonData, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling workflow: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, body)
+	}
+
+	var createdWorkflow Workflow
+	if err := json.Unmarshal(body, &createdWorkflow); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &createdWorkflow, nil
+}
+
+// GetWorkflow retrieves a workflow by ID
+func (c *VapiClient) GetWorkflow(ctx context.Context, id string) (*Workflow, error) {
+	url := fmt.Sprintf("%s/workflow/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "workflow", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var workflow Workflow
+	if err := json.Unmarshal(body, &workflow); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &workflow, nil
+}
+
+// UpdateWorkflow updates an existing workflow
+func (c *VapiClient) UpdateWorkflow(ctx context.Context, id string, workflow *Workflow) (*Workflow, error) {
+	url := fmt.Sprintf("%s/workflow/%s", c.BaseURL, id)
+
+	jsonData, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling workflow: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &NotFoundError{Resource: "workflow", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, body)
+	}
+
+	var updatedWorkflow Workflow
+	if err := json.Unmarshal(body, &updatedWorkflow); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updatedWorkflow, nil
+}
+
+// DeleteWorkflow deletes a workflow by ID
+func (c *VapiClient) DeleteWorkflow(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/workflow/%s", c.BaseURL, id)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: "workflow", ID: id}
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
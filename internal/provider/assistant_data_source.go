@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AssistantDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AssistantDataSource{}
+
+func NewAssistantDataSource() datasource.DataSource {
+	return &AssistantDataSource{}
+}
+
+// AssistantDataSource defines the data source implementation.
+type AssistantDataSource struct {
+	client *client.VapiClient
+}
+
+// AssistantDataSourceModel describes the data source data model, which has the
+// same attributes as the vapi_assistant resource.
+type AssistantDataSourceModel struct {
+	AssistantAttributesModel
+}
+
+func (d *AssistantDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assistant"
+}
+
+func (d *AssistantDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Start from the resource schema so the data source exposes every assistant attribute
+	var resourceResp resource.SchemaResponse
+	NewAssistantResource().Schema(ctx, resource.SchemaRequest{}, &resourceResp)
+	resp.Diagnostics.Append(resourceResp.Diagnostics...)
+
+	attributes, diags := computedAttributes(resourceResp.Schema.Attributes)
+	resp.Diagnostics.Append(diags...)
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Assistant identifier. Exactly one of `id` or `name` must be set",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Exact assistant name. Exactly one of `id` or `name` must be set, and the name must match a single assistant",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["system_message"] = schema.StringAttribute{
+		MarkdownDescription: "System message for the assistant. Same as `model.system_prompt`",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up an existing Vapi assistant by ID or name",
+
+		Attributes: attributes,
+	}
+}
+
+func (d *AssistantDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data AssistantDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of id or name must be set to look up an assistant.",
+		)
+	}
+}
+
+func (d *AssistantDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AssistantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssistantDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assistant *client.Assistant
+
+	if !data.ID.IsNull() {
+		found, err := d.client.GetAssistant(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read assistant, got error: %s", err))
+			return
+		}
+		assistant = found
+	} else {
+		assistants, err := d.client.ListAssistants(ctx, client.ListOptions{})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list assistants, got error: %s", err))
+			return
+		}
+
+		name := data.Name.ValueString()
+		var ids []string
+		for i := range assistants {
+			if assistants[i].Name == name {
+				assistant = &assistants[i]
+				ids = append(ids, assistants[i].ID)
+			}
+		}
+
+		if len(ids) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Assistant Not Found",
+				fmt.Sprintf("No assistant is named %q.", name),
+			)
+			return
+		}
+
+		if len(ids) > 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Ambiguous Assistant Name",
+				fmt.Sprintf("%d assistants are named %q (%v). Look the assistant up by id instead.", len(ids), name, ids),
+			)
+			return
+		}
+	}
+
+	// Map the assistant the same way an import does, populating every attribute
+	var model AssistantResourceModel
+	resp.Diagnostics.Append(assistantToModel(ctx, assistant, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AssistantAttributesModel = model.AssistantAttributesModel
+	data.ID = types.StringValue(assistant.ID)
+	data.SystemMessage = types.StringNull()
+	if assistant.Model != nil {
+		data.SystemMessage = stringValueOrNull(assistant.Model.SystemPrompt)
+	}
+	data.CreatedAt = stringValueOrNull(assistant.CreatedAt)
+	data.UpdatedAt = stringValueOrNull(assistant.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,14 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"terraform-provider-vapi/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -18,6 +27,64 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AssistantResource{}
 var _ resource.ResourceWithImportState = &AssistantResource{}
+var _ resource.ResourceWithValidateConfig = &AssistantResource{}
+var _ resource.ResourceWithModifyPlan = &AssistantResource{}
+
+// assistantFieldRenames maps Vapi field paths to differently named assistant attributes
+var assistantFieldRenames = map[string]string{
+	"model.provider":       "model.provider_type",
+	"voice.provider":       "voice.provider_type",
+	"transcriber.provider": "transcriber.provider_type",
+}
+
+// assistantModelProviders lists the model providers Vapi accepts
+var assistantModelProviders = []string{
+	"anthropic", "anyscale", "cerebras", "custom-llm", "deep-seek", "deepinfra", "google", "groq",
+	"inflection-ai", "openai", "openrouter", "perplexity-ai", "together-ai", "vapi", "xai",
+}
+
+// assistantVoiceProviders lists the voice providers Vapi accepts
+var assistantVoiceProviders = []string{
+	"11labs", "azure", "cartesia", "custom-voice", "deepgram", "hume", "lmnt", "neets",
+	"neuphonic", "openai", "playht", "rime-ai", "smallest-ai", "tavus", "vapi",
+}
+
+// assistantTranscriberProviders lists the transcriber providers Vapi accepts
+var assistantTranscriberProviders = []string{
+	"11labs", "assembly-ai", "azure", "cartesia", "custom-transcriber", "deepgram", "gladia",
+	"google", "openai", "speechmatics", "talkscriber",
+}
+
+// assistantBackgroundSounds lists the built-in background sounds. A URL to an audio file is also accepted.
+var assistantBackgroundSounds = []string{"off", "office"}
+
+// assistantClientMessages lists the events that can be sent to the client SDKs
+var assistantClientMessages = []string{
+	"conversation-update", "function-call", "function-call-result", "hang", "language-changed",
+	"metadata", "model-output", "speech-update", "status-update", "transcript", "tool-calls",
+	"tool-calls-result", "tool.completed", "transfer-update", "user-interrupted", "voice-input",
+	"workflow.node.started",
+}
+
+// assistantServerMessages lists the events that can be sent to the server URL
+var assistantServerMessages = []string{
+	"assistant.started", "conversation-update", "end-of-call-report", "function-call", "hang",
+	"knowledge-base-request", "language-change-detected", "language-changed", "model-output",
+	"phone-call-control", "speech-update", "status-update", "transcript",
+	`transcript[transcriptType="final"]`, "tool-calls", "transfer-destination-request",
+	"transfer-update", "user-interrupted", "voice-input",
+}
+
+// transcriberProviderOptions lists the transcriber providers that support each
+// provider-specific transcriber attribute
+var transcriberProviderOptions = map[string][]string{
+	"keywords":                         {"deepgram"},
+	"keyterm":                          {"deepgram"},
+	"smart_format":                     {"deepgram"},
+	"endpointing":                      {"deepgram"},
+	"confidence_threshold":             {"deepgram", "assembly-ai", "gladia"},
+	"end_of_turn_confidence_threshold": {"assembly-ai"},
+}
 
 func NewAssistantResource() resource.Resource {
 	return &AssistantResource{}
@@ -30,12 +97,20 @@ type AssistantResource struct {
 
 // AssistantResourceModel describes the resource data model.
 type AssistantResourceModel struct {
+	AssistantAttributesModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// AssistantAttributesModel describes the assistant attributes shared by the
+// resource and the vapi_assistant data source
+type AssistantAttributesModel struct {
 	ID                           types.String `tfsdk:"id"`
 	Name                         types.String `tfsdk:"name"`
 	FirstMessage                 types.String `tfsdk:"first_message"`
 	SystemMessage                types.String `tfsdk:"system_message"`
 	Model                        types.Object `tfsdk:"model"`
 	Voice                        types.Object `tfsdk:"voice"`
+	Transcriber                  types.Object `tfsdk:"transcriber"`
 	ClientMessages               types.List   `tfsdk:"client_messages"`
 	ServerMessages               types.List   `tfsdk:"server_messages"`
 	SilenceTimeoutSeconds        types.Int64  `tfsdk:"silence_timeout_seconds"`
@@ -44,6 +119,7 @@ type AssistantResourceModel struct {
 	BackgroundDenoisingEnabled   types.Bool   `tfsdk:"background_denoising_enabled"`
 	ModelOutputInMessagesEnabled types.Bool   `tfsdk:"model_output_in_messages_enabled"`
 	ServerURL                    types.String `tfsdk:"server_url"`
+	OrgID                        types.String `tfsdk:"org_id"`
 	CreatedAt                    types.String `tfsdk:"created_at"`
 	UpdatedAt                    types.String `tfsdk:"updated_at"`
 }
@@ -52,12 +128,22 @@ type AssistantResourceModel struct {
 type AssistantModelModel struct {
 	ProviderType              types.String  `tfsdk:"provider_type"`
 	Model                     types.String  `tfsdk:"model"`
+	SystemPrompt              types.String  `tfsdk:"system_prompt"`
 	Temperature               types.Float64 `tfsdk:"temperature"`
 	MaxTokens                 types.Int64   `tfsdk:"max_tokens"`
 	EmotionRecognitionEnabled types.Bool    `tfsdk:"emotion_recognition_enabled"`
 	NumFastTurns              types.Int64   `tfsdk:"num_fast_turns"`
 	ToolIds                   types.List    `tfsdk:"tool_ids"`
 	FunctionIds               types.List    `tfsdk:"function_ids"`
+	Messages                  types.List    `tfsdk:"messages"`
+	Tools                     types.List    `tfsdk:"tools"`
+	KnowledgeBaseID           types.String  `tfsdk:"knowledge_base_id"`
+}
+
+// AssistantModelMessageModel describes a message that primes the model
+type AssistantModelMessageModel struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
 }
 
 // AssistantVoiceModel describes the voice configuration
@@ -71,6 +157,69 @@ type AssistantVoiceModel struct {
 	UseSpeakerBoost types.Bool    `tfsdk:"use_speaker_boost"`
 }
 
+// AssistantTranscriberModel describes the transcriber configuration
+type AssistantTranscriberModel struct {
+	ProviderType                 types.String  `tfsdk:"provider_type"`
+	Model                        types.String  `tfsdk:"model"`
+	Language                     types.String  `tfsdk:"language"`
+	Keywords                     types.List    `tfsdk:"keywords"`
+	Keyterm                      types.List    `tfsdk:"keyterm"`
+	SmartFormat                  types.Bool    `tfsdk:"smart_format"`
+	Endpointing                  types.Int64   `tfsdk:"endpointing"`
+	ConfidenceThreshold          types.Float64 `tfsdk:"confidence_threshold"`
+	EndOfTurnConfidenceThreshold types.Float64 `tfsdk:"end_of_turn_confidence_threshold"`
+}
+
+func assistantModelAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider_type":               types.StringType,
+		"model":                       types.StringType,
+		"system_prompt":               types.StringType,
+		"temperature":                 types.Float64Type,
+		"max_tokens":                  types.Int64Type,
+		"emotion_recognition_enabled": types.BoolType,
+		"num_fast_turns":              types.Int64Type,
+		"tool_ids":                    types.ListType{ElemType: types.StringType},
+		"function_ids":                types.ListType{ElemType: types.StringType},
+		"messages":                    types.ListType{ElemType: types.ObjectType{AttrTypes: assistantModelMessageAttrTypes()}},
+		"tools":                       types.ListType{ElemType: types.ObjectType{AttrTypes: toolAttrTypes()}},
+		"knowledge_base_id":           types.StringType,
+	}
+}
+
+func assistantModelMessageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"role":    types.StringType,
+		"content": types.StringType,
+	}
+}
+
+func assistantVoiceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider_type":     types.StringType,
+		"voice_id":          types.StringType,
+		"speed":             types.Float64Type,
+		"stability":         types.Float64Type,
+		"similarity_boost":  types.Float64Type,
+		"style":             types.Float64Type,
+		"use_speaker_boost": types.BoolType,
+	}
+}
+
+func assistantTranscriberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider_type":                    types.StringType,
+		"model":                            types.StringType,
+		"language":                         types.StringType,
+		"keywords":                         types.ListType{ElemType: types.StringType},
+		"keyterm":                          types.ListType{ElemType: types.StringType},
+		"smart_format":                     types.BoolType,
+		"endpointing":                      types.Int64Type,
+		"confidence_threshold":             types.Float64Type,
+		"end_of_turn_confidence_threshold": types.Float64Type,
+	}
+}
+
 func (r *AssistantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_assistant"
 }
@@ -97,8 +246,9 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 			},
 			"system_message": schema.StringAttribute{
-				MarkdownDescription: "System message for the assistant",
+				MarkdownDescription: "System message for the assistant. Deprecated, use `model.system_prompt` instead. Without a `model` block it is sent with the provider and model the assistant already has",
 				Optional:            true,
+				DeprecationMessage:  "Use model.system_prompt instead. Without a model block, system_message is sent with the provider and model the assistant already has, and it can't be used to create an assistant.",
 			},
 			"model": schema.SingleNestedAttribute{
 				MarkdownDescription: "Model configuration for the assistant",
@@ -107,14 +257,24 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 					"provider_type": schema.StringAttribute{
 						MarkdownDescription: "Model provider (e.g., openai, anthropic)",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(assistantModelProviders...),
+						},
 					},
 					"model": schema.StringAttribute{
 						MarkdownDescription: "Model name (e.g., gpt-4, claude-3-sonnet)",
 						Required:            true,
 					},
+					"system_prompt": schema.StringAttribute{
+						MarkdownDescription: "System prompt that guides the assistant's behavior and personality",
+						Optional:            true,
+					},
 					"temperature": schema.Float64Attribute{
-						MarkdownDescription: "Temperature for the model",
+						MarkdownDescription: "Temperature for the model, between 0 and 2",
 						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 2),
+						},
 					},
 					"max_tokens": schema.Int64Attribute{
 						MarkdownDescription: "Maximum tokens for the model",
@@ -138,6 +298,33 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 						Optional:            true,
 						ElementType:         types.StringType,
 					},
+					"messages": schema.ListNestedAttribute{
+						MarkdownDescription: "Messages that prime the model, in order",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"role": schema.StringAttribute{
+									MarkdownDescription: "Message role (system, user, assistant)",
+									Required:            true,
+								},
+								"content": schema.StringAttribute{
+									MarkdownDescription: "Message content",
+									Required:            true,
+								},
+							},
+						},
+					},
+					"tools": schema.ListNestedAttribute{
+						MarkdownDescription: "Tools defined inline for this assistant only. Takes the same attributes as vapi_tool",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: toolAttributes(),
+						},
+					},
+					"knowledge_base_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the knowledge base the model retrieves from. Use `vapi_knowledge_base` to manage it",
+						Optional:            true,
+					},
 				},
 			},
 			"voice": schema.SingleNestedAttribute{
@@ -145,8 +332,11 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"provider_type": schema.StringAttribute{
-						MarkdownDescription: "Voice provider (e.g., elevenlabs, playht)",
+						MarkdownDescription: "Voice provider (e.g., 11labs, playht)",
 						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(assistantVoiceProviders...),
+						},
 					},
 					"voice_id": schema.StringAttribute{
 						MarkdownDescription: "Voice ID",
@@ -174,15 +364,68 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 					},
 				},
 			},
+			"transcriber": schema.SingleNestedAttribute{
+				MarkdownDescription: "Speech-to-text configuration for the assistant. Vapi's default transcriber is used when unset",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"provider_type": schema.StringAttribute{
+						MarkdownDescription: "Transcriber provider (e.g., deepgram, assembly-ai, azure, gladia, talkscriber, speechmatics, google, openai)",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(assistantTranscriberProviders...),
+						},
+					},
+					"model": schema.StringAttribute{
+						MarkdownDescription: "Transcription model (e.g., nova-3)",
+						Optional:            true,
+					},
+					"language": schema.StringAttribute{
+						MarkdownDescription: "Language to transcribe (e.g., en, en-US)",
+						Optional:            true,
+					},
+					"keywords": schema.ListAttribute{
+						MarkdownDescription: "Keywords to boost, optionally with an intensifier such as `snuffleupagus:5`. Deepgram only",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"keyterm": schema.ListAttribute{
+						MarkdownDescription: "Key terms to improve recognition of with Nova-3 models. Deepgram only",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"smart_format": schema.BoolAttribute{
+						MarkdownDescription: "Whether to apply smart formatting to transcripts. Deepgram only",
+						Optional:            true,
+					},
+					"endpointing": schema.Int64Attribute{
+						MarkdownDescription: "Milliseconds of silence before an utterance is considered finished. Deepgram only",
+						Optional:            true,
+					},
+					"confidence_threshold": schema.Float64Attribute{
+						MarkdownDescription: "Transcripts below this confidence are discarded. Deepgram, assembly-ai and gladia only",
+						Optional:            true,
+					},
+					"end_of_turn_confidence_threshold": schema.Float64Attribute{
+						MarkdownDescription: "Confidence required to end the caller's turn. Assembly-ai only",
+						Optional:            true,
+					},
+				},
+			},
 			"client_messages": schema.ListAttribute{
 				MarkdownDescription: "List of client messages",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(assistantClientMessages...)),
+				},
 			},
 			"server_messages": schema.ListAttribute{
 				MarkdownDescription: "List of server messages",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(assistantServerMessages...)),
+				},
 			},
 			"silence_timeout_seconds": schema.Int64Attribute{
 				MarkdownDescription: "Silence timeout in seconds",
@@ -193,8 +436,11 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 			},
 			"background_sound": schema.StringAttribute{
-				MarkdownDescription: "Background sound",
+				MarkdownDescription: "Background sound (off, office) or the URL of an audio file",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.Any(stringvalidator.OneOf(assistantBackgroundSounds...), httpURL()),
+				},
 			},
 			"background_denoising_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether background denoising is enabled",
@@ -207,18 +453,95 @@ func (r *AssistantResource) Schema(ctx context.Context, req resource.SchemaReque
 			"server_url": schema.StringAttribute{
 				MarkdownDescription: "Server URL for webhook events",
 				Optional:            true,
+				Validators: []validator.String{
+					httpURL(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization the assistant belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Creation timestamp",
 				Computed:            true,
-				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
-				MarkdownDescription: "Last update timestamp", 
+				MarkdownDescription: "Last update timestamp",
 				Computed:            true,
-				Optional:            true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *AssistantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AssistantResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAssistantTranscriber(ctx, data.Transcriber)...)
+	resp.Diagnostics.Append(validateAssistantModelTools(ctx, data.Model)...)
+
+	if data.SystemMessage.IsNull() || data.Model.IsNull() || data.Model.IsUnknown() {
+		return
+	}
+
+	var modelData AssistantModelModel
+	resp.Diagnostics.Append(data.Model.As(ctx, &modelData, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !modelData.SystemPrompt.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("system_message"),
+			"Invalid Attribute Combination",
+			"system_message and model.system_prompt both set the system prompt. Remove the deprecated system_message.",
+		)
+	}
+}
+
+// ModifyPlan rejects creating an assistant from system_message alone. There is
+// no existing model to merge it into, and guessing a model would be wrong for
+// anyone not using OpenAI.
+func (r *AssistantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only creates are checked, updates merge into the remote model
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var data AssistantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SystemMessage.IsNull() && data.Model.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("system_message"),
+			"Missing Model Configuration",
+			"system_message requires a model block when creating an assistant. Add a model block with provider_type and model, and move the prompt to model.system_prompt.",
+		)
 	}
 }
 
@@ -252,7 +575,207 @@ func (r *AssistantResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// ModifyPlan rejects this during plan, but guard against sending an assistant without its prompt
+	if !data.SystemMessage.IsNull() && data.Model.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("system_message"),
+			"Missing Model Configuration",
+			"system_message requires a model block when creating an assistant.",
+		)
+		return
+	}
+
 	// Convert Terraform model to API model
+	assistant, diags := assistantFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the assistant
+	createdAssistant, err := r.client.CreateAssistant(ctx, assistant)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create assistant", err, assistantFieldRenames)
+		return
+	}
+
+	// Update the model with the created assistant data
+	data.ID = types.StringValue(createdAssistant.ID)
+
+	data.OrgID = types.StringValue(createdAssistant.OrgID)
+	data.CreatedAt = types.StringValue(createdAssistant.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdAssistant.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssistantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AssistantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the assistant from the API
+	assistant, err := r.client.GetAssistant(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read assistant, got error: %s", err))
+		return
+	}
+
+	// Update the model with the assistant data
+	resp.Diagnostics.Append(assistantToModel(ctx, assistant, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssistantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AssistantResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state AssistantResourceModel
+
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	assistant, diags := assistantFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assistant.NullFields = assistantNullFields(state, data)
+
+	// Without a model block, the deprecated system_message is sent with the
+	// provider and model the assistant already has instead of a full model
+	if data.Model.IsNull() && (!data.SystemMessage.IsNull() || (!state.SystemMessage.IsNull() && state.Model.IsNull())) {
+		remote, err := r.client.GetAssistant(ctx, data.ID.ValueString())
+		if err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to read assistant model", err, assistantFieldRenames)
+			return
+		}
+
+		if remote.Model == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("system_message"),
+				"Missing Model Configuration",
+				"The assistant has no model to merge system_message into. Add a model block with provider_type and model, and move the prompt to model.system_prompt.",
+			)
+			return
+		}
+
+		// Only the provider and model are kept, other remote settings such as
+		// messages would be sent back stale
+		assistant.Model = &client.AssistantModel{
+			Provider:     remote.Model.Provider,
+			Model:        remote.Model.Model,
+			SystemPrompt: data.SystemMessage.ValueString(),
+		}
+	}
+
+	// Update the assistant
+	updatedAssistant, err := r.client.UpdateAssistant(ctx, data.ID.ValueString(), assistant)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update assistant", err, assistantFieldRenames)
+		return
+	}
+
+	// Update the model with the updated assistant data
+	data.OrgID = types.StringValue(updatedAssistant.OrgID)
+	data.CreatedAt = types.StringValue(updatedAssistant.CreatedAt)
+	data.UpdatedAt = types.StringValue(updatedAssistant.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssistantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AssistantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the assistant, treating one that is already gone as deleted
+	err := r.client.DeleteAssistant(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete assistant, got error: %s", err))
+		return
+	}
+}
+
+func (r *AssistantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// assistantFromModel converts the Terraform model into the API payload. It is
+// shared by Create and Update so both send the complete assistant configuration.
+func assistantFromModel(ctx context.Context, data AssistantResourceModel) (*client.Assistant, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	assistant := &client.Assistant{
 		Name: data.Name.ValueString(),
 	}
@@ -261,31 +784,24 @@ func (r *AssistantResource) Create(ctx context.Context, req resource.CreateReque
 		assistant.FirstMessage = data.FirstMessage.ValueString()
 	}
 
-	// Handle system message - it needs to be in the model object
-	if !data.SystemMessage.IsNull() {
-		if assistant.Model == nil {
-			assistant.Model = &client.AssistantModel{
-				Provider: "openai",      // Default provider
-				Model:    "gpt-4o-mini", // Default model
-			}
-		}
-		assistant.Model.SystemPrompt = data.SystemMessage.ValueString()
-	}
-
 	if !data.Model.IsNull() {
 		var modelData AssistantModelModel
-		resp.Diagnostics.Append(data.Model.As(ctx, &modelData, basetypes.ObjectAsOptions{})...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(data.Model.As(ctx, &modelData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		assistant.Model = &client.AssistantModel{
+			Provider:        modelData.ProviderType.ValueString(),
+			Model:           modelData.Model.ValueString(),
+			SystemPrompt:    modelData.SystemPrompt.ValueString(),
+			KnowledgeBaseID: modelData.KnowledgeBaseID.ValueString(),
 		}
 
-		// Preserve any existing model if system message was set
-		if assistant.Model == nil {
-			assistant.Model = &client.AssistantModel{}
+		// The deprecated system_message is sent inside the model object
+		if !data.SystemMessage.IsNull() {
+			assistant.Model.SystemPrompt = data.SystemMessage.ValueString()
 		}
-		
-		assistant.Model.Provider = modelData.ProviderType.ValueString()
-		assistant.Model.Model = modelData.Model.ValueString()
 
 		if !modelData.Temperature.IsNull() {
 			temp := modelData.Temperature.ValueFloat64()
@@ -309,28 +825,60 @@ func (r *AssistantResource) Create(ctx context.Context, req resource.CreateReque
 
 		if !modelData.ToolIds.IsNull() {
 			var toolIds []string
-			resp.Diagnostics.Append(modelData.ToolIds.ElementsAs(ctx, &toolIds, false)...)
-			if resp.Diagnostics.HasError() {
-				return
+			diags.Append(modelData.ToolIds.ElementsAs(ctx, &toolIds, false)...)
+			if diags.HasError() {
+				return nil, diags
 			}
 			assistant.Model.ToolIds = toolIds
 		}
 
 		if !modelData.FunctionIds.IsNull() {
 			var functionIds []string
-			resp.Diagnostics.Append(modelData.FunctionIds.ElementsAs(ctx, &functionIds, false)...)
-			if resp.Diagnostics.HasError() {
-				return
+			diags.Append(modelData.FunctionIds.ElementsAs(ctx, &functionIds, false)...)
+			if diags.HasError() {
+				return nil, diags
 			}
 			assistant.Model.FunctionIds = functionIds
 		}
+
+		if !modelData.Messages.IsNull() {
+			var messages []AssistantModelMessageModel
+			diags.Append(modelData.Messages.ElementsAs(ctx, &messages, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			for _, message := range messages {
+				assistant.Model.Messages = append(assistant.Model.Messages, client.AssistantModelMessage{
+					Role:    message.Role.ValueString(),
+					Content: message.Content.ValueString(),
+				})
+			}
+		}
+
+		if !modelData.Tools.IsNull() {
+			var tools []InlineToolModel
+			diags.Append(modelData.Tools.ElementsAs(ctx, &tools, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			for i, toolData := range tools {
+				tool, toolDiags := toolFromModel(ctx, toolData.toolModel(), path.Root("model").AtName("tools").AtListIndex(i))
+				diags.Append(toolDiags...)
+				if diags.HasError() {
+					return nil, diags
+				}
+				assistant.Model.Tools = append(assistant.Model.Tools, *tool)
+			}
+		}
 	}
 
 	if !data.Voice.IsNull() {
 		var voiceData AssistantVoiceModel
-		resp.Diagnostics.Append(data.Voice.As(ctx, &voiceData, basetypes.ObjectAsOptions{})...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(data.Voice.As(ctx, &voiceData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		assistant.Voice = &client.AssistantVoice{
@@ -364,20 +912,72 @@ func (r *AssistantResource) Create(ctx context.Context, req resource.CreateReque
 		}
 	}
 
+	if !data.Transcriber.IsNull() {
+		var transcriberData AssistantTranscriberModel
+		diags.Append(data.Transcriber.As(ctx, &transcriberData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		assistant.Transcriber = &client.AssistantTranscriber{
+			Provider: transcriberData.ProviderType.ValueString(),
+			Model:    transcriberData.Model.ValueString(),
+			Language: transcriberData.Language.ValueString(),
+		}
+
+		if !transcriberData.Keywords.IsNull() {
+			var keywords []string
+			diags.Append(transcriberData.Keywords.ElementsAs(ctx, &keywords, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			assistant.Transcriber.Keywords = keywords
+		}
+
+		if !transcriberData.Keyterm.IsNull() {
+			var keyterm []string
+			diags.Append(transcriberData.Keyterm.ElementsAs(ctx, &keyterm, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			assistant.Transcriber.Keyterm = keyterm
+		}
+
+		if !transcriberData.SmartFormat.IsNull() {
+			smartFormat := transcriberData.SmartFormat.ValueBool()
+			assistant.Transcriber.SmartFormat = &smartFormat
+		}
+
+		if !transcriberData.Endpointing.IsNull() {
+			endpointing := int(transcriberData.Endpointing.ValueInt64())
+			assistant.Transcriber.Endpointing = &endpointing
+		}
+
+		if !transcriberData.ConfidenceThreshold.IsNull() {
+			confidenceThreshold := transcriberData.ConfidenceThreshold.ValueFloat64()
+			assistant.Transcriber.ConfidenceThreshold = &confidenceThreshold
+		}
+
+		if !transcriberData.EndOfTurnConfidenceThreshold.IsNull() {
+			endOfTurnConfidenceThreshold := transcriberData.EndOfTurnConfidenceThreshold.ValueFloat64()
+			assistant.Transcriber.EndOfTurnConfidenceThreshold = &endOfTurnConfidenceThreshold
+		}
+	}
+
 	if !data.ClientMessages.IsNull() {
 		var clientMessages []string
-		resp.Diagnostics.Append(data.ClientMessages.ElementsAs(ctx, &clientMessages, false)...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(data.ClientMessages.ElementsAs(ctx, &clientMessages, false)...)
+		if diags.HasError() {
+			return nil, diags
 		}
 		assistant.ClientMessages = clientMessages
 	}
 
 	if !data.ServerMessages.IsNull() {
 		var serverMessages []string
-		resp.Diagnostics.Append(data.ServerMessages.ElementsAs(ctx, &serverMessages, false)...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(data.ServerMessages.ElementsAs(ctx, &serverMessages, false)...)
+		if diags.HasError() {
+			return nil, diags
 		}
 		assistant.ServerMessages = serverMessages
 	}
@@ -410,126 +1010,382 @@ func (r *AssistantResource) Create(ctx context.Context, req resource.CreateReque
 		assistant.ServerURL = data.ServerURL.ValueString()
 	}
 
-	// Create the assistant
-	createdAssistant, err := r.client.CreateAssistant(assistant)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create assistant, got error: %s", err))
-		return
+	return assistant, diags
+}
+
+// assistantNullFields returns the API fields that are set in the prior state but
+// removed from the plan, so the PATCH request clears them on the server. Fields
+// of the model, voice and transcriber are cleared individually while the
+// object itself is kept, since Vapi merges nested objects on update.
+func assistantNullFields(state, plan AssistantResourceModel) []string {
+	candidates := []struct {
+		field   string
+		prior   attr.Value
+		planned attr.Value
+	}{
+		{"firstMessage", state.FirstMessage, plan.FirstMessage},
+		{"voice", state.Voice, plan.Voice},
+		{"transcriber", state.Transcriber, plan.Transcriber},
+		{"clientMessages", state.ClientMessages, plan.ClientMessages},
+		{"serverMessages", state.ServerMessages, plan.ServerMessages},
+		{"silenceTimeoutSeconds", state.SilenceTimeoutSeconds, plan.SilenceTimeoutSeconds},
+		{"maxDurationSeconds", state.MaxDurationSeconds, plan.MaxDurationSeconds},
+		{"backgroundSound", state.BackgroundSound, plan.BackgroundSound},
+		{"backgroundDenoisingEnabled", state.BackgroundDenoisingEnabled, plan.BackgroundDenoisingEnabled},
+		{"modelOutputInMessagesEnabled", state.ModelOutputInMessagesEnabled, plan.ModelOutputInMessagesEnabled},
+		{"serverUrl", state.ServerURL, plan.ServerURL},
 	}
 
-	// Update the model with the created assistant data
-	data.ID = types.StringValue(createdAssistant.ID)
-	
-	// For now, set timestamp fields to null since VAPI API may not return them consistently
-	// This prevents "unknown value" errors while keeping the fields available
-	data.CreatedAt = types.StringNull()
-	data.UpdatedAt = types.StringNull()
+	var nullFields []string
+	for _, candidate := range candidates {
+		if !candidate.prior.IsNull() && candidate.planned.IsNull() {
+			nullFields = append(nullFields, candidate.field)
+		}
+	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// Removing only system_message keeps the remote model, Update merges the change into it instead
+	if !state.Model.IsNull() && plan.Model.IsNull() && plan.SystemMessage.IsNull() {
+		nullFields = append(nullFields, "model")
+	}
+
+	modelFields := map[string]string{
+		"temperature":                 "temperature",
+		"max_tokens":                  "maxTokens",
+		"emotion_recognition_enabled": "emotionRecognitionEnabled",
+		"num_fast_turns":              "numFastTurns",
+		"tool_ids":                    "toolIds",
+		"function_ids":                "functionIds",
+		"messages":                    "messages",
+		"tools":                       "tools",
+		"knowledge_base_id":           "knowledgeBaseId",
+	}
+	// The deprecated system_message is sent as the system prompt in its place
+	if plan.SystemMessage.IsNull() {
+		modelFields["system_prompt"] = "systemPrompt"
+	}
+	nullFields = append(nullFields, nestedNullFields("model", state.Model, plan.Model, modelFields)...)
+
+	// Removing system_message clears the prompt it set, unless the model block sets one or is removed as a whole
+	if !state.SystemMessage.IsNull() && plan.SystemMessage.IsNull() && objectAttribute(plan.Model, "system_prompt").IsNull() &&
+		(state.Model.IsNull() || !plan.Model.IsNull()) && !slices.Contains(nullFields, "model.systemPrompt") {
+		nullFields = append(nullFields, "model.systemPrompt")
+	}
+
+	nullFields = append(nullFields, nestedNullFields("voice", state.Voice, plan.Voice, map[string]string{
+		"speed":             "speed",
+		"stability":         "stability",
+		"similarity_boost":  "similarityBoost",
+		"style":             "style",
+		"use_speaker_boost": "useSpeakerBoost",
+	})...)
+	nullFields = append(nullFields, nestedNullFields("transcriber", state.Transcriber, plan.Transcriber, map[string]string{
+		"model":                            "model",
+		"language":                         "language",
+		"keywords":                         "keywords",
+		"keyterm":                          "keyterm",
+		"smart_format":                     "smartFormat",
+		"endpointing":                      "endpointing",
+		"confidence_threshold":             "confidenceThreshold",
+		"end_of_turn_confidence_threshold": "endOfTurnConfidenceThreshold",
+	})...)
+
+	return nullFields
 }
 
-func (r *AssistantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data AssistantResourceModel
+// assistantToModel copies the API response into the Terraform model. Optional
+// attributes missing from the prior state are only populated after an import,
+// when the prior state holds nothing but the ID.
+func assistantToModel(ctx context.Context, assistant *client.Assistant, data *AssistantResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	importing := data.Name.IsNull()
 
-	if resp.Diagnostics.HasError() {
-		return
+	data.Name = types.StringValue(assistant.Name)
+	data.FirstMessage = refreshString(data.FirstMessage, assistant.FirstMessage, importing)
+
+	systemPrompt := ""
+	if assistant.Model != nil {
+		systemPrompt = assistant.Model.SystemPrompt
 	}
+	// Imports populate model.system_prompt rather than the deprecated system_message
+	data.SystemMessage = refreshString(data.SystemMessage, systemPrompt, false)
 
-	// Get the assistant from the API
-	assistant, err := r.client.GetAssistant(data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read assistant, got error: %s", err))
-		return
+	// A model that is only configured remotely, such as one system_message was merged into, is not tracked
+	if assistant.Model == nil || (data.Model.IsNull() && !importing) {
+		data.Model = types.ObjectNull(assistantModelAttrTypes())
+	} else {
+		var priorModel AssistantModelModel
+		if !data.Model.IsNull() {
+			diags.Append(data.Model.As(ctx, &priorModel, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		modelData := AssistantModelModel{
+			ProviderType:              types.StringValue(assistant.Model.Provider),
+			Model:                     types.StringValue(assistant.Model.Model),
+			SystemPrompt:              refreshString(priorModel.SystemPrompt, assistant.Model.SystemPrompt, importing),
+			Temperature:               refreshFloat64(priorModel.Temperature, assistant.Model.Temperature, importing),
+			MaxTokens:                 refreshInt64(priorModel.MaxTokens, assistant.Model.MaxTokens, importing),
+			EmotionRecognitionEnabled: refreshBool(priorModel.EmotionRecognitionEnabled, assistant.Model.EmotionRecognitionEnabled, importing),
+			NumFastTurns:              refreshInt64(priorModel.NumFastTurns, assistant.Model.NumFastTurns, importing),
+			KnowledgeBaseID:           refreshString(priorModel.KnowledgeBaseID, assistant.Model.KnowledgeBaseID, importing),
+		}
+
+		var listDiags diag.Diagnostics
+		modelData.ToolIds, listDiags = refreshStringList(ctx, priorModel.ToolIds, assistant.Model.ToolIds, importing)
+		diags.Append(listDiags...)
+		modelData.FunctionIds, listDiags = refreshStringList(ctx, priorModel.FunctionIds, assistant.Model.FunctionIds, importing)
+		diags.Append(listDiags...)
+		modelData.Messages, listDiags = refreshAssistantModelMessages(ctx, priorModel.Messages, assistant.Model.Messages, importing)
+		diags.Append(listDiags...)
+		modelData.Tools, listDiags = refreshAssistantModelTools(ctx, priorModel.Tools, assistant.Model.Tools, importing)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		modelObject, objectDiags := types.ObjectValueFrom(ctx, assistantModelAttrTypes(), modelData)
+		diags.Append(objectDiags...)
+		data.Model = modelObject
 	}
 
-	// Update the model with the assistant data
-	data.Name = types.StringValue(assistant.Name)
-	data.FirstMessage = types.StringValue(assistant.FirstMessage)
-	if assistant.Model != nil {
-		data.SystemMessage = types.StringValue(assistant.Model.SystemPrompt)
+	if assistant.Voice == nil || (data.Voice.IsNull() && !importing) {
+		data.Voice = types.ObjectNull(assistantVoiceAttrTypes())
 	} else {
-		data.SystemMessage = types.StringValue("")
+		var priorVoice AssistantVoiceModel
+		if !data.Voice.IsNull() {
+			diags.Append(data.Voice.As(ctx, &priorVoice, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		voiceData := AssistantVoiceModel{
+			ProviderType:    types.StringValue(assistant.Voice.Provider),
+			VoiceID:         types.StringValue(assistant.Voice.VoiceID),
+			Speed:           refreshFloat64(priorVoice.Speed, assistant.Voice.Speed, importing),
+			Stability:       refreshFloat64(priorVoice.Stability, assistant.Voice.Stability, importing),
+			SimilarityBoost: refreshFloat64(priorVoice.SimilarityBoost, assistant.Voice.SimilarityBoost, importing),
+			Style:           refreshFloat64(priorVoice.Style, assistant.Voice.Style, importing),
+			UseSpeakerBoost: refreshBool(priorVoice.UseSpeakerBoost, assistant.Voice.UseSpeakerBoost, importing),
+		}
+
+		voiceObject, objectDiags := types.ObjectValueFrom(ctx, assistantVoiceAttrTypes(), voiceData)
+		diags.Append(objectDiags...)
+		data.Voice = voiceObject
 	}
-	data.ServerURL = types.StringValue(assistant.ServerURL)
-	// For now, set timestamp fields to null since VAPI API may not return them consistently
-	// This prevents "unknown value" errors while keeping the fields available
-	data.CreatedAt = types.StringNull()
-	data.UpdatedAt = types.StringNull()
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+	if assistant.Transcriber == nil || (data.Transcriber.IsNull() && !importing) {
+		data.Transcriber = types.ObjectNull(assistantTranscriberAttrTypes())
+	} else {
+		var priorTranscriber AssistantTranscriberModel
+		if !data.Transcriber.IsNull() {
+			diags.Append(data.Transcriber.As(ctx, &priorTranscriber, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
 
-func (r *AssistantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data AssistantResourceModel
+		transcriberData := AssistantTranscriberModel{
+			ProviderType:                 types.StringValue(assistant.Transcriber.Provider),
+			Model:                        refreshString(priorTranscriber.Model, assistant.Transcriber.Model, importing),
+			Language:                     refreshString(priorTranscriber.Language, assistant.Transcriber.Language, importing),
+			SmartFormat:                  refreshBool(priorTranscriber.SmartFormat, assistant.Transcriber.SmartFormat, importing),
+			Endpointing:                  refreshInt64(priorTranscriber.Endpointing, assistant.Transcriber.Endpointing, importing),
+			ConfidenceThreshold:          refreshFloat64(priorTranscriber.ConfidenceThreshold, assistant.Transcriber.ConfidenceThreshold, importing),
+			EndOfTurnConfidenceThreshold: refreshFloat64(priorTranscriber.EndOfTurnConfidenceThreshold, assistant.Transcriber.EndOfTurnConfidenceThreshold, importing),
+		}
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		var listDiags diag.Diagnostics
+		transcriberData.Keywords, listDiags = refreshStringList(ctx, priorTranscriber.Keywords, assistant.Transcriber.Keywords, importing)
+		diags.Append(listDiags...)
+		transcriberData.Keyterm, listDiags = refreshStringList(ctx, priorTranscriber.Keyterm, assistant.Transcriber.Keyterm, importing)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
 
-	if resp.Diagnostics.HasError() {
-		return
+		transcriberObject, objectDiags := types.ObjectValueFrom(ctx, assistantTranscriberAttrTypes(), transcriberData)
+		diags.Append(objectDiags...)
+		data.Transcriber = transcriberObject
 	}
 
-	// Convert Terraform model to API model (similar to Create)
-	assistant := &client.Assistant{
-		Name: data.Name.ValueString(),
+	var listDiags diag.Diagnostics
+	data.ClientMessages, listDiags = refreshStringList(ctx, data.ClientMessages, assistant.ClientMessages, importing)
+	diags.Append(listDiags...)
+	data.ServerMessages, listDiags = refreshStringList(ctx, data.ServerMessages, assistant.ServerMessages, importing)
+	diags.Append(listDiags...)
+
+	data.SilenceTimeoutSeconds = refreshInt64(data.SilenceTimeoutSeconds, assistant.SilenceTimeoutSeconds, importing)
+	data.MaxDurationSeconds = refreshInt64(data.MaxDurationSeconds, assistant.MaxDurationSeconds, importing)
+	data.BackgroundSound = refreshString(data.BackgroundSound, assistant.BackgroundSound, importing)
+	data.BackgroundDenoisingEnabled = refreshBool(data.BackgroundDenoisingEnabled, assistant.BackgroundDenoisingEnabled, importing)
+	data.ModelOutputInMessagesEnabled = refreshBool(data.ModelOutputInMessagesEnabled, assistant.ModelOutputInMessagesEnabled, importing)
+	data.ServerURL = refreshString(data.ServerURL, assistant.ServerURL, importing)
+
+	data.OrgID = types.StringValue(assistant.OrgID)
+	data.CreatedAt = types.StringValue(assistant.CreatedAt)
+	data.UpdatedAt = types.StringValue(assistant.UpdatedAt)
+
+	return diags
+}
+
+// validateAssistantTranscriber rejects provider-specific transcriber attributes
+// that the configured provider does not support
+func validateAssistantTranscriber(ctx context.Context, transcriber types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if transcriber.IsNull() || transcriber.IsUnknown() {
+		return diags
 	}
 
-	if !data.FirstMessage.IsNull() {
-		assistant.FirstMessage = data.FirstMessage.ValueString()
+	var transcriberData AssistantTranscriberModel
+	diags.Append(transcriber.As(ctx, &transcriberData, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() || transcriberData.ProviderType.IsUnknown() {
+		return diags
 	}
 
-	// Handle system message - it needs to be in the model object
-	if !data.SystemMessage.IsNull() {
-		if assistant.Model == nil {
-			assistant.Model = &client.AssistantModel{
-				Provider: "openai",      // Default provider
-				Model:    "gpt-4o-mini", // Default model
-			}
+	provider := transcriberData.ProviderType.ValueString()
+	options := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"keywords", transcriberData.Keywords},
+		{"keyterm", transcriberData.Keyterm},
+		{"smart_format", transcriberData.SmartFormat},
+		{"endpointing", transcriberData.Endpointing},
+		{"confidence_threshold", transcriberData.ConfidenceThreshold},
+		{"end_of_turn_confidence_threshold", transcriberData.EndOfTurnConfidenceThreshold},
+	}
+
+	for _, option := range options {
+		if option.value.IsNull() {
+			continue
+		}
+
+		name := option.name
+		supported := transcriberProviderOptions[name]
+		if slices.Contains(supported, provider) {
+			continue
 		}
-		assistant.Model.SystemPrompt = data.SystemMessage.ValueString()
+
+		diags.AddAttributeError(
+			path.Root("transcriber").AtName(name),
+			"Unsupported Transcriber Attribute",
+			fmt.Sprintf("transcriber.%s is only supported by the %s transcriber provider(s), not %q.", name, strings.Join(supported, ", "), provider),
+		)
 	}
 
-	if !data.ServerURL.IsNull() {
-		assistant.ServerURL = data.ServerURL.ValueString()
+	return diags
+}
+
+// refreshAssistantModelMessages maps the model messages back into state, following refreshStringList
+func refreshAssistantModelMessages(ctx context.Context, prior types.List, messages []client.AssistantModelMessage, populate bool) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: assistantModelMessageAttrTypes()}
+
+	if prior.IsNull() && !populate {
+		return types.ListNull(elemType), nil
 	}
 
-	// Update the assistant
-	updatedAssistant, err := r.client.UpdateAssistant(data.ID.ValueString(), assistant)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update assistant, got error: %s", err))
-		return
+	// Keep an explicitly empty list from the configuration instead of flipping it to null
+	if len(messages) == 0 {
+		if !prior.IsNull() && len(prior.Elements()) == 0 {
+			return types.ListValueMust(elemType, []attr.Value{}), nil
+		}
+		return types.ListNull(elemType), nil
 	}
 
-	// Update the model with the updated assistant data
-	data.UpdatedAt = types.StringValue(updatedAssistant.UpdatedAt)
+	messageData := make([]AssistantModelMessageModel, 0, len(messages))
+	for _, message := range messages {
+		messageData = append(messageData, AssistantModelMessageModel{
+			Role:    types.StringValue(message.Role),
+			Content: types.StringValue(message.Content),
+		})
+	}
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return types.ListValueFrom(ctx, elemType, messageData)
 }
 
-func (r *AssistantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data AssistantResourceModel
+// refreshAssistantModelTools maps inline tools back into state. Each tool is
+// refreshed against the prior tool at the same position so that values the API
+// doesn't return, such as server secrets, are kept.
+func refreshAssistantModelTools(ctx context.Context, prior types.List, tools []client.Tool, populate bool) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	elemType := types.ObjectType{AttrTypes: toolAttrTypes()}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if prior.IsNull() && !populate {
+		return types.ListNull(elemType), diags
 	}
 
-	// Delete the assistant
-	err := r.client.DeleteAssistant(data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete assistant, got error: %s", err))
-		return
+	// Keep an explicitly empty list from the configuration instead of flipping it to null
+	if len(tools) == 0 {
+		if !prior.IsNull() && len(prior.Elements()) == 0 {
+			return types.ListValueMust(elemType, []attr.Value{}), diags
+		}
+		return types.ListNull(elemType), diags
 	}
+
+	var priorTools []InlineToolModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorTools, false)...)
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
+		}
+	}
+
+	toolData := make([]InlineToolModel, 0, len(tools))
+	for i := range tools {
+		data := ToolResourceModel{
+			Async:        types.BoolNull(),
+			Function:     types.ObjectNull(toolFunctionAttrTypes()),
+			Server:       types.ObjectNull(toolServerAttrTypes()),
+			Messages:     types.ObjectNull(toolMessagesAttrTypes()),
+			TransferCall: types.ObjectNull(toolTransferCallAttrTypes()),
+			Query:        types.ObjectNull(toolQueryAttrTypes()),
+		}
+		if i < len(priorTools) {
+			data = priorTools[i].toolModel()
+		}
+
+		diags.Append(toolToModel(ctx, &tools[i], &data)...)
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
+		}
+		toolData = append(toolData, inlineToolModel(data))
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, elemType, toolData)
+	diags.Append(listDiags...)
+
+	return list, diags
 }
 
-func (r *AssistantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// validateAssistantModelTools applies the vapi_tool validation to each inline tool
+func validateAssistantModelTools(ctx context.Context, model types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model.IsNull() || model.IsUnknown() {
+		return diags
+	}
+
+	var modelData AssistantModelModel
+	diags.Append(model.As(ctx, &modelData, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() || modelData.Tools.IsNull() || modelData.Tools.IsUnknown() {
+		return diags
+	}
+
+	var tools []InlineToolModel
+	diags.Append(modelData.Tools.ElementsAs(ctx, &tools, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, tool := range tools {
+		diags.Append(validateTool(tool.toolModel(), path.Root("model").AtName("tools").AtListIndex(i))...)
+	}
+
+	return diags
 }
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// objectValue builds an object of the given attribute types from values,
+// leaving every other attribute null
+func objectValue(t *testing.T, attrTypes map[string]attr.Type, values map[string]attr.Value) types.Object {
+	t.Helper()
+
+	ctx := context.Background()
+	attributes := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+
+		null, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+		if err != nil {
+			t.Fatalf("null %s: %s", name, err)
+		}
+		attributes[name] = null
+	}
+
+	object, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		t.Fatalf("object diagnostics: %v", diags)
+	}
+
+	return object
+}
+
+// testAssistantModel returns an assistant model with only a name set
+func testAssistantModel() AssistantResourceModel {
+	var data AssistantResourceModel
+	data.Name = types.StringValue("Support")
+	data.Model = types.ObjectNull(assistantModelAttrTypes())
+	data.Voice = types.ObjectNull(assistantVoiceAttrTypes())
+	data.Transcriber = types.ObjectNull(assistantTranscriberAttrTypes())
+
+	return data
+}
+
+func TestAssistantNullFields(t *testing.T) {
+	model := func(values map[string]attr.Value) types.Object {
+		values["provider_type"] = types.StringValue("openai")
+		values["model"] = types.StringValue("gpt-4o")
+		return objectValue(t, assistantModelAttrTypes(), values)
+	}
+	voice := func(values map[string]attr.Value) types.Object {
+		values["provider_type"] = types.StringValue("11labs")
+		values["voice_id"] = types.StringValue("burt")
+		return objectValue(t, assistantVoiceAttrTypes(), values)
+	}
+	transcriber := func(values map[string]attr.Value) types.Object {
+		values["provider_type"] = types.StringValue("deepgram")
+		return objectValue(t, assistantTranscriberAttrTypes(), values)
+	}
+
+	tests := []struct {
+		name  string
+		state func(*AssistantResourceModel)
+		plan  func(*AssistantResourceModel)
+		want  []string
+	}{
+		{
+			name: "nothing removed",
+			state: func(data *AssistantResourceModel) {
+				data.FirstMessage = types.StringValue("Hello")
+				data.Model = model(map[string]attr.Value{"temperature": types.Float64Value(0.5)})
+			},
+			plan: func(data *AssistantResourceModel) {
+				data.FirstMessage = types.StringValue("Hi")
+				data.Model = model(map[string]attr.Value{"temperature": types.Float64Value(0.7)})
+			},
+			want: nil,
+		},
+		{
+			name: "top level fields",
+			state: func(data *AssistantResourceModel) {
+				data.FirstMessage = types.StringValue("Hello")
+				data.ServerURL = types.StringValue("https://example.com/vapi")
+				data.Voice = voice(map[string]attr.Value{})
+			},
+			plan: func(data *AssistantResourceModel) {},
+			want: []string{"firstMessage", "voice", "serverUrl"},
+		},
+		{
+			name: "model fields",
+			state: func(data *AssistantResourceModel) {
+				data.Model = model(map[string]attr.Value{
+					"system_prompt":     types.StringValue("Be helpful"),
+					"temperature":       types.Float64Value(0.5),
+					"max_tokens":        types.Int64Value(250),
+					"knowledge_base_id": types.StringValue("kb-1"),
+				})
+			},
+			plan: func(data *AssistantResourceModel) {
+				data.Model = model(map[string]attr.Value{
+					"temperature": types.Float64Value(0.5),
+				})
+			},
+			want: []string{"model.knowledgeBaseId", "model.maxTokens", "model.systemPrompt"},
+		},
+		{
+			name: "system prompt replaced by system_message",
+			state: func(data *AssistantResourceModel) {
+				data.Model = model(map[string]attr.Value{"system_prompt": types.StringValue("Be helpful")})
+			},
+			plan: func(data *AssistantResourceModel) {
+				data.SystemMessage = types.StringValue("Be brief")
+				data.Model = model(map[string]attr.Value{})
+			},
+			want: nil,
+		},
+		{
+			name: "system_message removed",
+			state: func(data *AssistantResourceModel) {
+				data.SystemMessage = types.StringValue("Be brief")
+			},
+			plan: func(data *AssistantResourceModel) {},
+			want: []string{"model.systemPrompt"},
+		},
+		{
+			name: "system_message removed from a model block",
+			state: func(data *AssistantResourceModel) {
+				data.SystemMessage = types.StringValue("Be brief")
+				data.Model = model(map[string]attr.Value{})
+			},
+			plan: func(data *AssistantResourceModel) {
+				data.Model = model(map[string]attr.Value{})
+			},
+			want: []string{"model.systemPrompt"},
+		},
+		{
+			name: "system_message moved to system_prompt",
+			state: func(data *AssistantResourceModel) {
+				data.SystemMessage = types.StringValue("Be brief")
+				data.Model = model(map[string]attr.Value{})
+			},
+			plan: func(data *AssistantResourceModel) {
+				data.Model = model(map[string]attr.Value{"system_prompt": types.StringValue("Be brief")})
+			},
+			want: nil,
+		},
+		{
+			name: "voice fields",
+			state: func(data *AssistantResourceModel) {
+				data.Voice = voice(map[string]attr.Value{
+					"speed":             types.Float64Value(1.1),
+					"stability":         types.Float64Value(0.5),
+					"use_speaker_boost": types.BoolValue(true),
+				})
+			},
+			plan: func(data *AssistantResourceModel) {
+				data.Voice = voice(map[string]attr.Value{"speed": types.Float64Value(1.2)})
+			},
+			want: []string{"voice.stability", "voice.useSpeakerBoost"},
+		},
+		{
+			name: "transcriber fields",
+			state: func(data *AssistantResourceModel) {
+				data.Transcriber = transcriber(map[string]attr.Value{
+					"language":     types.StringValue("en"),
+					"smart_format": types.BoolValue(true),
+					"keywords":     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("vapi")}),
+				})
+			},
+			plan: func(data *AssistantResourceModel) {
+				data.Transcriber = transcriber(map[string]attr.Value{})
+			},
+			want: []string{"transcriber.keywords", "transcriber.language", "transcriber.smartFormat"},
+		},
+		{
+			name: "removed object is cleared as a whole",
+			state: func(data *AssistantResourceModel) {
+				data.Transcriber = transcriber(map[string]attr.Value{"language": types.StringValue("en")})
+			},
+			plan: func(data *AssistantResourceModel) {},
+			want: []string{"transcriber"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, plan := testAssistantModel(), testAssistantModel()
+			tt.state(&state)
+			tt.plan(&plan)
+
+			if got := assistantNullFields(state, plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AssistantsDataSource{}
+
+func NewAssistantsDataSource() datasource.DataSource {
+	return &AssistantsDataSource{}
+}
+
+// AssistantsDataSource defines the data source implementation.
+type AssistantsDataSource struct {
+	client *client.VapiClient
+}
+
+// AssistantsDataSourceModel describes the data source data model.
+type AssistantsDataSourceModel struct {
+	NamePrefix        types.String            `tfsdk:"name_prefix"`
+	NameRegex         types.String            `tfsdk:"name_regex"`
+	ModelProviderType types.String            `tfsdk:"model_provider_type"`
+	CreatedAfter      types.String            `tfsdk:"created_after"`
+	CreatedBefore     types.String            `tfsdk:"created_before"`
+	MaxResults        types.Int64             `tfsdk:"max_results"`
+	IDs               []types.String          `tfsdk:"ids"`
+	Assistants        []AssistantSummaryModel `tfsdk:"assistants"`
+}
+
+// AssistantSummaryModel describes an assistant returned by the vapi_assistants data source
+type AssistantSummaryModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	FirstMessage      types.String `tfsdk:"first_message"`
+	ModelProviderType types.String `tfsdk:"model_provider_type"`
+	Model             types.String `tfsdk:"model"`
+	VoiceProviderType types.String `tfsdk:"voice_provider_type"`
+	VoiceID           types.String `tfsdk:"voice_id"`
+	ServerURL         types.String `tfsdk:"server_url"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+}
+
+func (d *AssistantsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assistants"
+}
+
+func (d *AssistantsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the Vapi assistants that match every configured filter",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return assistants whose name starts with this prefix",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return assistants whose name matches this regular expression",
+				Optional:            true,
+			},
+			"model_provider_type": schema.StringAttribute{
+				MarkdownDescription: "Only return assistants using this model provider (e.g., openai, anthropic)",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only return assistants created after this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only return assistants created before this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of assistants to return, newest first. Defaults to all matching assistants",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching assistants",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"assistants": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching assistants",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Assistant identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Assistant name",
+							Computed:            true,
+						},
+						"first_message": schema.StringAttribute{
+							MarkdownDescription: "First message the assistant will say",
+							Computed:            true,
+						},
+						"model_provider_type": schema.StringAttribute{
+							MarkdownDescription: "Model provider",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "Model name",
+							Computed:            true,
+						},
+						"voice_provider_type": schema.StringAttribute{
+							MarkdownDescription: "Voice provider",
+							Computed:            true,
+						},
+						"voice_id": schema.StringAttribute{
+							MarkdownDescription: "Voice ID",
+							Computed:            true,
+						},
+						"server_url": schema.StringAttribute{
+							MarkdownDescription: "Server URL for webhook events",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last update timestamp",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AssistantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AssistantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssistantsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.NamePrefix, data.NameRegex, data.CreatedAfter, data.CreatedBefore)
+	resp.Diagnostics.Append(diags...)
+
+	if !data.MaxResults.IsNull() && data.MaxResults.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_results"),
+			"Invalid Max Results",
+			fmt.Sprintf("max_results must be at least 1, got: %d.", data.MaxResults.ValueInt64()),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.IDs = []types.String{}
+	data.Assistants = []AssistantSummaryModel{}
+
+	// Name and provider filters are applied locally, so the cap counts matches rather than fetched items
+	it := d.client.IterateAssistants(filter.listOptions())
+	for it.Next(ctx) {
+		assistant := it.Value()
+		if !filter.matches(assistant.Name, assistant.CreatedAt) {
+			continue
+		}
+
+		summary := AssistantSummaryModel{
+			ID:                types.StringValue(assistant.ID),
+			Name:              types.StringValue(assistant.Name),
+			FirstMessage:      stringValueOrNull(assistant.FirstMessage),
+			ModelProviderType: types.StringNull(),
+			Model:             types.StringNull(),
+			VoiceProviderType: types.StringNull(),
+			VoiceID:           types.StringNull(),
+			ServerURL:         stringValueOrNull(assistant.ServerURL),
+			CreatedAt:         stringValueOrNull(assistant.CreatedAt),
+			UpdatedAt:         stringValueOrNull(assistant.UpdatedAt),
+		}
+
+		if assistant.Model != nil {
+			summary.ModelProviderType = stringValueOrNull(assistant.Model.Provider)
+			summary.Model = stringValueOrNull(assistant.Model.Model)
+		}
+
+		if !data.ModelProviderType.IsNull() && summary.ModelProviderType.ValueString() != data.ModelProviderType.ValueString() {
+			continue
+		}
+
+		if assistant.Voice != nil {
+			summary.VoiceProviderType = stringValueOrNull(assistant.Voice.Provider)
+			summary.VoiceID = stringValueOrNull(assistant.Voice.VoiceID)
+		}
+
+		data.IDs = append(data.IDs, summary.ID)
+		data.Assistants = append(data.Assistants, summary)
+
+		if !data.MaxResults.IsNull() && int64(len(data.Assistants)) >= data.MaxResults.ValueInt64() {
+			break
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list assistants, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
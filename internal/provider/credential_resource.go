@@ -0,0 +1,807 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CredentialResource{}
+var _ resource.ResourceWithImportState = &CredentialResource{}
+var _ resource.ResourceWithValidateConfig = &CredentialResource{}
+
+// credentialAPIKeyProviders lists the providers whose credential is a single API key
+var credentialAPIKeyProviders = []string{
+	"11labs", "anthropic", "assembly-ai", "cartesia", "deepgram", "deepinfra", "gladia", "google",
+	"groq", "lmnt", "openai", "openrouter", "perplexity-ai", "rime-ai", "together-ai", "xai",
+}
+
+// credentialProviders lists the credential providers supported by the vapi_credential resource
+var credentialProviders = append(slices.Clone(credentialAPIKeyProviders), "gcp", "s3", "twilio", "vonage")
+
+// credentialBlocks lists the attributes holding each kind of credential
+var credentialBlocks = []string{"api_key", "twilio", "vonage", "s3", "gcp"}
+
+// credentialBlock returns the attribute holding the credential for a provider
+func credentialBlock(provider string) string {
+	switch provider {
+	case "twilio", "vonage", "s3", "gcp":
+		return provider
+	default:
+		return "api_key"
+	}
+}
+
+// credentialFieldRenames maps Vapi field paths to the credential attributes of
+// a provider. The same API field lives in a different block per provider.
+func credentialFieldRenames(provider string) map[string]string {
+	renames := map[string]string{
+		"provider": "provider_type",
+	}
+
+	switch provider {
+	case "twilio":
+		renames["accountSid"] = "twilio.account_sid"
+		renames["authToken"] = "twilio.auth_token"
+		renames["apiKey"] = "twilio.api_key"
+		renames["apiSecret"] = "twilio.api_secret"
+	case "vonage":
+		renames["apiKey"] = "vonage.api_key"
+		renames["apiSecret"] = "vonage.api_secret"
+	case "s3":
+		renames["awsAccessKeyId"] = "s3.access_key_id"
+		renames["awsSecretAccessKey"] = "s3.secret_access_key"
+		renames["region"] = "s3.region"
+		renames["s3BucketName"] = "s3.bucket_name"
+		renames["s3PathPrefix"] = "s3.path_prefix"
+	case "gcp":
+		renames["gcpKey"] = "gcp.key"
+		renames["bucketPlan.name"] = "gcp.bucket_name"
+		renames["bucketPlan.region"] = "gcp.region"
+		renames["bucketPlan.path"] = "gcp.path"
+	default:
+		renames["apiKey"] = "api_key.value"
+	}
+
+	return renames
+}
+
+func NewCredentialResource() resource.Resource {
+	return &CredentialResource{}
+}
+
+// CredentialResource defines the resource implementation.
+type CredentialResource struct {
+	client *client.VapiClient
+}
+
+// CredentialResourceModel describes the resource data model.
+type CredentialResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProviderType  types.String `tfsdk:"provider_type"`
+	Name          types.String `tfsdk:"name"`
+	APIKey        types.Object `tfsdk:"api_key"`
+	Twilio        types.Object `tfsdk:"twilio"`
+	Vonage        types.Object `tfsdk:"vonage"`
+	S3            types.Object `tfsdk:"s3"`
+	GCP           types.Object `tfsdk:"gcp"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+	OrgID         types.String `tfsdk:"org_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// CredentialAPIKeyModel describes a credential that is a single API key
+type CredentialAPIKeyModel struct {
+	Value types.String `tfsdk:"value"`
+}
+
+// CredentialTwilioModel describes a Twilio account credential
+type CredentialTwilioModel struct {
+	AccountSid types.String `tfsdk:"account_sid"`
+	AuthToken  types.String `tfsdk:"auth_token"`
+	APIKey     types.String `tfsdk:"api_key"`
+	APISecret  types.String `tfsdk:"api_secret"`
+}
+
+// CredentialVonageModel describes a Vonage account credential
+type CredentialVonageModel struct {
+	APIKey    types.String `tfsdk:"api_key"`
+	APISecret types.String `tfsdk:"api_secret"`
+}
+
+// CredentialS3Model describes an S3 bucket Vapi uploads call artifacts to
+type CredentialS3Model struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Region          types.String `tfsdk:"region"`
+	BucketName      types.String `tfsdk:"bucket_name"`
+	PathPrefix      types.String `tfsdk:"path_prefix"`
+}
+
+// CredentialGCPModel describes a GCS bucket Vapi uploads call artifacts to
+type CredentialGCPModel struct {
+	Key        types.String `tfsdk:"key"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	Region     types.String `tfsdk:"region"`
+	Path       types.String `tfsdk:"path"`
+}
+
+func credentialAPIKeyAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"value": types.StringType,
+	}
+}
+
+func credentialTwilioAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"account_sid": types.StringType,
+		"auth_token":  types.StringType,
+		"api_key":     types.StringType,
+		"api_secret":  types.StringType,
+	}
+}
+
+func credentialVonageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"api_key":    types.StringType,
+		"api_secret": types.StringType,
+	}
+}
+
+func credentialS3AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"access_key_id":     types.StringType,
+		"secret_access_key": types.StringType,
+		"region":            types.StringType,
+		"bucket_name":       types.StringType,
+		"path_prefix":       types.StringType,
+	}
+}
+
+func credentialGCPAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":         types.StringType,
+		"bucket_name": types.StringType,
+		"region":      types.StringType,
+		"path":        types.StringType,
+	}
+}
+
+func (r *CredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vapi Credential resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Credential identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Provider the credential is for (e.g., openai, 11labs, deepgram, twilio, vonage, s3, gcp)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(credentialProviders...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name for the credential",
+				Optional:            true,
+			},
+			"api_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "API key for model, voice and transcriber providers such as openai, anthropic, 11labs or deepgram",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"value": schema.StringAttribute{
+						MarkdownDescription: "The API key",
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+				},
+			},
+			"twilio": schema.SingleNestedAttribute{
+				MarkdownDescription: "Twilio account. Set auth_token, or api_key and api_secret",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"account_sid": schema.StringAttribute{
+						MarkdownDescription: "Twilio Account SID",
+						Required:            true,
+					},
+					"auth_token": schema.StringAttribute{
+						MarkdownDescription: "Twilio Auth Token",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"api_key": schema.StringAttribute{
+						MarkdownDescription: "Twilio API Key",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"api_secret": schema.StringAttribute{
+						MarkdownDescription: "Twilio API Secret",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+				},
+			},
+			"vonage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Vonage account",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						MarkdownDescription: "Vonage API Key",
+						Required:            true,
+					},
+					"api_secret": schema.StringAttribute{
+						MarkdownDescription: "Vonage API Secret",
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+				},
+			},
+			"s3": schema.SingleNestedAttribute{
+				MarkdownDescription: "S3 bucket Vapi uploads recordings and other call artifacts to",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"access_key_id": schema.StringAttribute{
+						MarkdownDescription: "AWS access key ID",
+						Required:            true,
+					},
+					"secret_access_key": schema.StringAttribute{
+						MarkdownDescription: "AWS secret access key",
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "AWS region of the bucket (e.g., us-east-1)",
+						Required:            true,
+					},
+					"bucket_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the bucket",
+						Required:            true,
+					},
+					"path_prefix": schema.StringAttribute{
+						MarkdownDescription: "Path prefix for uploaded objects",
+						Optional:            true,
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				MarkdownDescription: "Google Cloud Storage bucket Vapi uploads recordings and other call artifacts to",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						MarkdownDescription: "Service account key as a JSON-encoded string (e.g., file(\"key.json\"))",
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"bucket_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the bucket",
+						Required:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region of the bucket",
+						Optional:            true,
+					},
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path prefix for uploaded objects",
+						Optional:            true,
+					},
+				},
+			},
+			"secret_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the write-only secrets. Terraform doesn't store or compare secrets, so change this value to send rotated secrets to Vapi",
+				Optional:            true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization the credential belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// credentialBlockValue returns the value of the credential block named attribute
+func credentialBlockValue(data CredentialResourceModel, attribute string) types.Object {
+	switch attribute {
+	case "twilio":
+		return data.Twilio
+	case "vonage":
+		return data.Vonage
+	case "s3":
+		return data.S3
+	case "gcp":
+		return data.GCP
+	default:
+		return data.APIKey
+	}
+}
+
+func (r *CredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProviderType.IsUnknown() || data.ProviderType.IsNull() {
+		return
+	}
+
+	provider := data.ProviderType.ValueString()
+	expected := credentialBlock(provider)
+
+	for _, block := range credentialBlocks {
+		value := credentialBlockValue(data, block)
+
+		if block == expected && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("The %s block is required when provider_type is %q.", block, provider),
+			)
+		}
+
+		if block != expected && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The %s block can't be set when provider_type is %q, use the %s block instead.", block, provider, expected),
+			)
+		}
+	}
+
+	if !data.Twilio.IsNull() && !data.Twilio.IsUnknown() {
+		var twilio CredentialTwilioModel
+		resp.Diagnostics.Append(data.Twilio.As(ctx, &twilio, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if twilio.AuthToken.IsNull() && (twilio.APIKey.IsNull() || twilio.APISecret.IsNull()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("twilio"),
+				"Missing Attribute Configuration",
+				"The twilio block requires auth_token, or both api_key and api_secret.",
+			)
+		}
+	}
+
+	if !data.GCP.IsNull() && !data.GCP.IsUnknown() {
+		var gcp CredentialGCPModel
+		resp.Diagnostics.Append(data.GCP.As(ctx, &gcp, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if _, err := parseJSONObject(gcp.Key); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("gcp").AtName("key"),
+				"Invalid Service Account Key",
+				fmt.Sprintf("The service account key must be a JSON object: %s", err),
+			)
+		}
+	}
+}
+
+func (r *CredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config CredentialResourceModel
+
+	// Read Terraform configuration data, the only place write-only secrets are available
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	credential, diags := credentialFromModel(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the credential
+	createdCredential, err := r.client.CreateCredential(ctx, credential)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create credential", err, credentialFieldRenames(credential.Provider))
+		return
+	}
+
+	// Update the model with the created credential data
+	data.ID = types.StringValue(createdCredential.ID)
+	data.OrgID = types.StringValue(createdCredential.OrgID)
+	data.CreatedAt = types.StringValue(createdCredential.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdCredential.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the credential from the API
+	credential, err := r.client.GetCredential(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential, got error: %s", err))
+		return
+	}
+
+	// Update the model with the credential data
+	resp.Diagnostics.Append(credentialToModel(ctx, credential, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config CredentialResourceModel
+
+	// Read Terraform configuration data, the only place write-only secrets are available
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	credential, diags := credentialFromModel(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CredentialResourceModel
+
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential.NullFields = credentialNullFields(state, data)
+
+	// The provider is immutable and is rejected in update payloads
+	provider := credential.Provider
+	credential.Provider = ""
+
+	// Update the credential
+	updatedCredential, err := r.client.UpdateCredential(ctx, data.ID.ValueString(), credential)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update credential", err, credentialFieldRenames(provider))
+		return
+	}
+
+	// Update the model with the updated credential data
+	data.OrgID = types.StringValue(updatedCredential.OrgID)
+	data.CreatedAt = types.StringValue(updatedCredential.CreatedAt)
+	data.UpdatedAt = types.StringValue(updatedCredential.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the credential, treating one that is already gone as deleted
+	err := r.client.DeleteCredential(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete credential, got error: %s", err))
+		return
+	}
+}
+
+func (r *CredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// credentialFromModel converts the Terraform model into the API payload. Only
+// the block matching provider_type is sent. Secrets are write-only, so data
+// must come from the configuration rather than the plan.
+func credentialFromModel(ctx context.Context, data CredentialResourceModel) (*client.Credential, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	credential := &client.Credential{
+		Provider: data.ProviderType.ValueString(),
+		Name:     data.Name.ValueString(),
+	}
+
+	switch credentialBlock(credential.Provider) {
+	case "twilio":
+		var twilio CredentialTwilioModel
+		diags.Append(data.Twilio.As(ctx, &twilio, basetypes.ObjectAsOptions{})...)
+		credential.AccountSid = twilio.AccountSid.ValueString()
+		credential.AuthToken = twilio.AuthToken.ValueString()
+		credential.APIKey = twilio.APIKey.ValueString()
+		credential.APISecret = twilio.APISecret.ValueString()
+	case "vonage":
+		var vonage CredentialVonageModel
+		diags.Append(data.Vonage.As(ctx, &vonage, basetypes.ObjectAsOptions{})...)
+		credential.APIKey = vonage.APIKey.ValueString()
+		credential.APISecret = vonage.APISecret.ValueString()
+	case "s3":
+		var s3 CredentialS3Model
+		diags.Append(data.S3.As(ctx, &s3, basetypes.ObjectAsOptions{})...)
+		credential.AWSAccessKeyID = s3.AccessKeyID.ValueString()
+		credential.AWSSecretAccessKey = s3.SecretAccessKey.ValueString()
+		credential.Region = s3.Region.ValueString()
+		credential.S3BucketName = s3.BucketName.ValueString()
+		credential.S3PathPrefix = s3.PathPrefix.ValueString()
+	case "gcp":
+		var gcp CredentialGCPModel
+		diags.Append(data.GCP.As(ctx, &gcp, basetypes.ObjectAsOptions{})...)
+
+		key, err := parseJSONObject(gcp.Key)
+		if err != nil {
+			diags.AddAttributeError(path.Root("gcp").AtName("key"), "Invalid Service Account Key", err.Error())
+			return nil, diags
+		}
+
+		credential.GCPKey = key
+		credential.BucketPlan = &client.CredentialBucketPlan{
+			Name:   gcp.BucketName.ValueString(),
+			Region: gcp.Region.ValueString(),
+			Path:   gcp.Path.ValueString(),
+		}
+	default:
+		var apiKey CredentialAPIKeyModel
+		diags.Append(data.APIKey.As(ctx, &apiKey, basetypes.ObjectAsOptions{})...)
+		credential.APIKey = apiKey.Value.ValueString()
+	}
+
+	return credential, diags
+}
+
+// credentialNullFields returns the API fields that are set in the prior state
+// but removed from the plan, so the PATCH request clears them on the server.
+// Secrets are never stored in state, so only the other attributes are compared.
+func credentialNullFields(state, plan CredentialResourceModel) []string {
+	var nullFields []string
+
+	if !state.Name.IsNull() && plan.Name.IsNull() {
+		nullFields = append(nullFields, "name")
+	}
+
+	// S3 settings are top-level fields of the credential rather than a nested object
+	if len(nestedNullFields("s3", state.S3, plan.S3, map[string]string{"path_prefix": "s3PathPrefix"})) > 0 {
+		nullFields = append(nullFields, "s3PathPrefix")
+	}
+
+	nullFields = append(nullFields, nestedNullFields("bucketPlan", state.GCP, plan.GCP, map[string]string{
+		"region": "region",
+		"path":   "path",
+	})...)
+
+	return nullFields
+}
+
+// credentialToModel copies the API response into the Terraform model. Secrets
+// are write-only and never stored, so they are always null in state.
+func credentialToModel(ctx context.Context, credential *client.Credential, data *CredentialResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	importing := data.ProviderType.IsNull()
+
+	data.ProviderType = types.StringValue(credential.Provider)
+	data.Name = refreshString(data.Name, credential.Name, importing)
+
+	block := credentialBlock(credential.Provider)
+
+	if block != "api_key" {
+		data.APIKey = types.ObjectNull(credentialAPIKeyAttrTypes())
+	} else {
+		apiKeyObject, objectDiags := types.ObjectValueFrom(ctx, credentialAPIKeyAttrTypes(), CredentialAPIKeyModel{
+			Value: types.StringNull(),
+		})
+		diags.Append(objectDiags...)
+		data.APIKey = apiKeyObject
+	}
+
+	if block != "twilio" {
+		data.Twilio = types.ObjectNull(credentialTwilioAttrTypes())
+	} else {
+		var prior CredentialTwilioModel
+		if !data.Twilio.IsNull() {
+			diags.Append(data.Twilio.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		twilioObject, objectDiags := types.ObjectValueFrom(ctx, credentialTwilioAttrTypes(), CredentialTwilioModel{
+			AccountSid: stringValueOrPrior(prior.AccountSid, credential.AccountSid),
+			AuthToken:  types.StringNull(),
+			APIKey:     types.StringNull(),
+			APISecret:  types.StringNull(),
+		})
+		diags.Append(objectDiags...)
+		data.Twilio = twilioObject
+	}
+
+	if block != "vonage" {
+		data.Vonage = types.ObjectNull(credentialVonageAttrTypes())
+	} else {
+		var prior CredentialVonageModel
+		if !data.Vonage.IsNull() {
+			diags.Append(data.Vonage.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		vonageObject, objectDiags := types.ObjectValueFrom(ctx, credentialVonageAttrTypes(), CredentialVonageModel{
+			APIKey:    stringValueOrPrior(prior.APIKey, credential.APIKey),
+			APISecret: types.StringNull(),
+		})
+		diags.Append(objectDiags...)
+		data.Vonage = vonageObject
+	}
+
+	if block != "s3" {
+		data.S3 = types.ObjectNull(credentialS3AttrTypes())
+	} else {
+		var prior CredentialS3Model
+		if !data.S3.IsNull() {
+			diags.Append(data.S3.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		s3Object, objectDiags := types.ObjectValueFrom(ctx, credentialS3AttrTypes(), CredentialS3Model{
+			AccessKeyID:     stringValueOrPrior(prior.AccessKeyID, credential.AWSAccessKeyID),
+			SecretAccessKey: types.StringNull(),
+			Region:          stringValueOrPrior(prior.Region, credential.Region),
+			BucketName:      stringValueOrPrior(prior.BucketName, credential.S3BucketName),
+			PathPrefix:      refreshString(prior.PathPrefix, credential.S3PathPrefix, importing),
+		})
+		diags.Append(objectDiags...)
+		data.S3 = s3Object
+	}
+
+	if block != "gcp" {
+		data.GCP = types.ObjectNull(credentialGCPAttrTypes())
+	} else {
+		var prior CredentialGCPModel
+		if !data.GCP.IsNull() {
+			diags.Append(data.GCP.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		gcp := CredentialGCPModel{
+			Key:        types.StringNull(),
+			BucketName: prior.BucketName,
+			Region:     prior.Region,
+			Path:       prior.Path,
+		}
+		if credential.BucketPlan != nil {
+			gcp.BucketName = stringValueOrPrior(prior.BucketName, credential.BucketPlan.Name)
+			gcp.Region = refreshString(prior.Region, credential.BucketPlan.Region, importing)
+			gcp.Path = refreshString(prior.Path, credential.BucketPlan.Path, importing)
+		}
+
+		gcpObject, objectDiags := types.ObjectValueFrom(ctx, credentialGCPAttrTypes(), gcp)
+		diags.Append(objectDiags...)
+		data.GCP = gcpObject
+	}
+
+	data.OrgID = types.StringValue(credential.OrgID)
+	data.CreatedAt = types.StringValue(credential.CreatedAt)
+	data.UpdatedAt = types.StringValue(credential.UpdatedAt)
+
+	return diags
+}
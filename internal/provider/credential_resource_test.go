@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCredentialModel returns a credential model for provider with only the provider set
+func testCredentialModel(provider string) CredentialResourceModel {
+	return CredentialResourceModel{
+		ProviderType: types.StringValue(provider),
+		Name:         types.StringNull(),
+		APIKey:       types.ObjectNull(credentialAPIKeyAttrTypes()),
+		Twilio:       types.ObjectNull(credentialTwilioAttrTypes()),
+		Vonage:       types.ObjectNull(credentialVonageAttrTypes()),
+		S3:           types.ObjectNull(credentialS3AttrTypes()),
+		GCP:          types.ObjectNull(credentialGCPAttrTypes()),
+	}
+}
+
+func TestCredentialNullFields(t *testing.T) {
+	s3 := func(values map[string]attr.Value) types.Object {
+		values["region"] = types.StringValue("us-east-1")
+		values["bucket_name"] = types.StringValue("recordings")
+		return objectValue(t, credentialS3AttrTypes(), values)
+	}
+	gcp := func(values map[string]attr.Value) types.Object {
+		values["bucket_name"] = types.StringValue("recordings")
+		return objectValue(t, credentialGCPAttrTypes(), values)
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		state    func(*CredentialResourceModel)
+		plan     func(*CredentialResourceModel)
+		want     []string
+	}{
+		{
+			name:     "nothing removed",
+			provider: "openai",
+			state: func(data *CredentialResourceModel) {
+				data.Name = types.StringValue("OpenAI")
+			},
+			plan: func(data *CredentialResourceModel) {
+				data.Name = types.StringValue("OpenAI production")
+			},
+			want: nil,
+		},
+		{
+			name:     "name",
+			provider: "openai",
+			state: func(data *CredentialResourceModel) {
+				data.Name = types.StringValue("OpenAI")
+			},
+			plan: func(data *CredentialResourceModel) {},
+			want: []string{"name"},
+		},
+		{
+			name:     "s3 path prefix",
+			provider: "s3",
+			state: func(data *CredentialResourceModel) {
+				data.S3 = s3(map[string]attr.Value{"path_prefix": types.StringValue("calls/")})
+			},
+			plan: func(data *CredentialResourceModel) {
+				data.S3 = s3(map[string]attr.Value{})
+			},
+			want: []string{"s3PathPrefix"},
+		},
+		{
+			name:     "gcp bucket region and path",
+			provider: "gcp",
+			state: func(data *CredentialResourceModel) {
+				data.Name = types.StringValue("Recordings")
+				data.GCP = gcp(map[string]attr.Value{
+					"region": types.StringValue("us-central1"),
+					"path":   types.StringValue("calls/"),
+				})
+			},
+			plan: func(data *CredentialResourceModel) {
+				data.Name = types.StringValue("Recordings")
+				data.GCP = gcp(map[string]attr.Value{})
+			},
+			want: []string{"bucketPlan.path", "bucketPlan.region"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, plan := testCredentialModel(tt.provider), testCredentialModel(tt.provider)
+			tt.state(&state)
+			tt.plan(&plan)
+
+			if got := credentialNullFields(state, plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// computedAttributes converts resource schema attributes into computed data
+// source attributes, keeping descriptions and sensitivity. Data sources that
+// read the same object as a resource build their schema from it, so attributes
+// added to the resource are exposed by the data source as well. Write-only
+// attributes are never returned by the API and are left out.
+func computedAttributes(attributes map[string]resourceschema.Attribute) (map[string]schema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	computed := computedAttributesAt(path.Empty(), attributes, &diags)
+
+	return computed, diags
+}
+
+func computedAttributesAt(parent path.Path, attributes map[string]resourceschema.Attribute, diags *diag.Diagnostics) map[string]schema.Attribute {
+	computed := make(map[string]schema.Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		if attribute.IsWriteOnly() {
+			continue
+		}
+
+		attributePath := parent.AtName(name)
+		description := attribute.GetMarkdownDescription()
+		sensitive := attribute.IsSensitive()
+
+		switch a := attribute.(type) {
+		case resourceschema.StringAttribute:
+			computed[name] = schema.StringAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+		case resourceschema.Int64Attribute:
+			computed[name] = schema.Int64Attribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+		case resourceschema.Float64Attribute:
+			computed[name] = schema.Float64Attribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+		case resourceschema.BoolAttribute:
+			computed[name] = schema.BoolAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+		case resourceschema.ListAttribute:
+			computed[name] = schema.ListAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, ElementType: a.ElementType}
+		case resourceschema.MapAttribute:
+			computed[name] = schema.MapAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, ElementType: a.ElementType}
+		case resourceschema.SingleNestedAttribute:
+			computed[name] = schema.SingleNestedAttribute{
+				MarkdownDescription: description,
+				Sensitive:           sensitive,
+				Computed:            true,
+				Attributes:          computedAttributesAt(attributePath, a.Attributes, diags),
+			}
+		case resourceschema.ListNestedAttribute:
+			computed[name] = schema.ListNestedAttribute{
+				MarkdownDescription: description,
+				Sensitive:           sensitive,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAttributesAt(attributePath, a.NestedObject.Attributes, diags),
+				},
+			}
+		default:
+			diags.AddAttributeError(
+				attributePath,
+				"Unsupported Data Source Attribute",
+				fmt.Sprintf("Attributes of type %T can't be converted into a data source attribute. Please report this issue to the provider developers.", attribute),
+			)
+		}
+	}
+
+	return computed
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAssistantDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	var resp datasource.SchemaResponse
+	NewAssistantDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", resp.Diagnostics)
+	}
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("invalid schema: %v", diags)
+	}
+
+	// Every resource attribute is exposed by the data source, and nothing else
+	resourceType := resourceSchema(t, NewAssistantResource()).Type().TerraformType(ctx).(tftypes.Object)
+	dataSourceType := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	delete(resourceType.AttributeTypes, "timeouts")
+	if !resourceType.Equal(dataSourceType) {
+		t.Errorf("data source type %s differs from resource type %s", dataSourceType, resourceType)
+	}
+
+	for name, attribute := range resp.Schema.Attributes {
+		if !attribute.IsComputed() {
+			t.Errorf("attribute %s is not computed", name)
+		}
+	}
+
+	if _, ok := resp.Schema.Attributes["org_id"].(schema.StringAttribute); !ok {
+		t.Error("org_id is missing")
+	}
+	if _, ok := resp.Schema.Attributes["model"].(schema.SingleNestedAttribute).Attributes["tools"].(schema.ListNestedAttribute); !ok {
+		t.Error("model.tools is not a list of nested attributes")
+	}
+
+	// The model has to match the schema for the state to be set
+	assistant := &client.Assistant{
+		ID:    "assistant-1",
+		Name:  "Support",
+		OrgID: "org-1",
+		Model: &client.AssistantModel{
+			Provider: "openai",
+			Model:    "gpt-4o",
+			Tools: []client.Tool{{
+				Type:     "function",
+				Function: &client.ToolFunction{Name: "lookup"},
+			}},
+		},
+	}
+
+	var model AssistantResourceModel
+	if diags := assistantToModel(ctx, assistant, &model); diags.HasError() {
+		t.Fatalf("mapping diagnostics: %v", diags)
+	}
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(dataSourceType, nil),
+	}
+	if diags := state.Set(ctx, &AssistantDataSourceModel{AssistantAttributesModel: model.AssistantAttributesModel}); diags.HasError() {
+		t.Errorf("model doesn't match the schema: %v", diags)
+	}
+}
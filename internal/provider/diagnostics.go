@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// schemaTypeLookup is satisfied by the schema attached to a plan, state or config
+type schemaTypeLookup interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// addClientError reports an error returned by the Vapi client. Validation
+// messages that name a request field are attached to the matching Terraform
+// attribute, so the CLI points at the offending configuration. Field paths are
+// converted from camelCase to snake_case; fieldRenames maps Vapi field path
+// prefixes whose Terraform attribute has a different name (e.g. "model.provider"
+// to "model.provider_type").
+func addClientError(ctx context.Context, diags *diag.Diagnostics, s schemaTypeLookup, action string, err error, fieldRenames map[string]string) {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		attributeErrors := 0
+		for _, fieldErr := range apiErr.FieldErrors() {
+			attributePath, ok := attributePathForField(ctx, s, fieldErr.Field, fieldRenames)
+			if !ok {
+				continue
+			}
+
+			diags.AddAttributeError(attributePath, "Invalid Attribute Value", fmt.Sprintf("%s: %s", action, fieldErr.Message))
+			attributeErrors++
+		}
+
+		// Every message was attached to an attribute, the general error would only repeat them
+		if attributeErrors > 0 && attributeErrors == len(apiErr.Messages) {
+			return
+		}
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", action, err))
+}
+
+// attributePathForField maps a dotted Vapi field path such as "model.maxTokens"
+// or "members.0.assistantId" to the closest attribute path in the schema.
+func attributePathForField(ctx context.Context, s schemaTypeLookup, field string, fieldRenames map[string]string) (path.Path, bool) {
+	for vapiPrefix, terraformPrefix := range fieldRenames {
+		if field == vapiPrefix || strings.HasPrefix(field, vapiPrefix+".") {
+			field = terraformPrefix + strings.TrimPrefix(field, vapiPrefix)
+			break
+		}
+	}
+
+	segments := strings.Split(field, ".")
+	attributePath := path.Empty()
+	for _, segment := range segments {
+		if index, err := strconv.Atoi(segment); err == nil {
+			attributePath = attributePath.AtListIndex(index)
+		} else {
+			attributePath = attributePath.AtName(camelToSnake(segment))
+		}
+	}
+
+	// Fall back to the nearest parent for fields that have no attribute of their own
+	for len(attributePath.Steps()) > 0 {
+		if _, diags := s.TypeAtPath(ctx, attributePath); !diags.HasError() {
+			return attributePath, true
+		}
+		attributePath = attributePath.ParentPath()
+	}
+
+	return path.Empty(), false
+}
+
+// camelToSnake converts an API field name such as "silenceTimeoutSeconds" to "silence_timeout_seconds"
+func camelToSnake(name string) string {
+	var b strings.Builder
+
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	return resp.Schema
+}
+
+func TestAttributePathForField(t *testing.T) {
+	assistantSchema := resourceSchema(t, NewAssistantResource())
+	squadSchema := resourceSchema(t, NewSquadResource())
+
+	tests := []struct {
+		name    string
+		schema  schema.Schema
+		field   string
+		renames map[string]string
+		want    path.Path
+		wantOK  bool
+	}{
+		{
+			name:   "top level field",
+			schema: assistantSchema,
+			field:  "silenceTimeoutSeconds",
+			want:   path.Root("silence_timeout_seconds"),
+			wantOK: true,
+		},
+		{
+			name:   "nested field",
+			schema: assistantSchema,
+			field:  "model.maxTokens",
+			want:   path.Root("model").AtName("max_tokens"),
+			wantOK: true,
+		},
+		{
+			name:   "nested list element",
+			schema: assistantSchema,
+			field:  "model.tools.0.function.name",
+			want:   path.Root("model").AtName("tools").AtListIndex(0).AtName("function").AtName("name"),
+			wantOK: true,
+		},
+		{
+			name:   "list element of a top level list",
+			schema: squadSchema,
+			field:  "members.1.assistantId",
+			want:   path.Root("members").AtListIndex(1).AtName("assistant_id"),
+			wantOK: true,
+		},
+		{
+			name:    "renamed field",
+			schema:  assistantSchema,
+			field:   "model.provider",
+			renames: assistantFieldRenames,
+			want:    path.Root("model").AtName("provider_type"),
+			wantOK:  true,
+		},
+		{
+			name:    "rename only applies to whole segments",
+			schema:  assistantSchema,
+			field:   "voice.providerOptions",
+			renames: assistantFieldRenames,
+			want:    path.Root("voice"),
+			wantOK:  true,
+		},
+		{
+			name:   "unmapped nested field falls back to its parent",
+			schema: assistantSchema,
+			field:  "voice.chunkPlan.enabled",
+			want:   path.Root("voice"),
+			wantOK: true,
+		},
+		{
+			name:   "unmapped list element field falls back to the element",
+			schema: assistantSchema,
+			field:  "model.tools.2.rejectionPlan",
+			want:   path.Root("model").AtName("tools").AtListIndex(2),
+			wantOK: true,
+		},
+		{
+			name:   "unmapped top level field",
+			schema: assistantSchema,
+			field:  "hooks",
+			wantOK: false,
+		},
+		{
+			name:   "unmapped top level field with children",
+			schema: assistantSchema,
+			field:  "analysisPlan.summaryPrompt",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := attributePathForField(context.Background(), tt.schema, tt.field, tt.renames)
+			if ok != tt.wantOK {
+				t.Fatalf("got ok %t, want %t", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("got path %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCamelToSnake(t *testing.T) {
+	tests := map[string]string{
+		"name":                  "name",
+		"maxTokens":             "max_tokens",
+		"silenceTimeoutSeconds": "silence_timeout_seconds",
+		"assistantId":           "assistant_id",
+		"Provider":              "provider",
+		"":                      "",
+	}
+
+	for name, want := range tests {
+		if got := camelToSnake(name); got != want {
+			t.Errorf("camelToSnake(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	assistantSchema := resourceSchema(t, NewAssistantResource())
+
+	tests := []struct {
+		name               string
+		err                error
+		wantAttributePaths []path.Path
+		wantGeneral        bool
+	}{
+		{
+			name: "every message mapped",
+			err: &client.APIError{
+				StatusCode: 400,
+				Messages:   []string{"model.temperature must not be greater than 2", "model.tools.0.function.name must be a string"},
+			},
+			wantAttributePaths: []path.Path{
+				path.Root("model").AtName("temperature"),
+				path.Root("model").AtName("tools").AtListIndex(0).AtName("function").AtName("name"),
+			},
+		},
+		{
+			name: "some messages unmapped",
+			err: &client.APIError{
+				StatusCode: 400,
+				Messages:   []string{"firstMessage must be a string", "Couldn't validate assistant"},
+			},
+			wantAttributePaths: []path.Path{path.Root("first_message")},
+			wantGeneral:        true,
+		},
+		{
+			name: "unknown field",
+			err: &client.APIError{
+				StatusCode: 400,
+				Messages:   []string{"property hooks should not exist"},
+			},
+			wantGeneral: true,
+		},
+		{
+			name: "non-JSON error body",
+			err: &client.APIError{
+				StatusCode: 502,
+				Messages:   []string{"<html>Bad Gateway</html>"},
+			},
+			wantGeneral: true,
+		},
+		{
+			name:        "not an API error",
+			err:         errors.New("error making request: connection refused"),
+			wantGeneral: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addClientError(context.Background(), &diags, assistantSchema, "Unable to create assistant", tt.err, assistantFieldRenames)
+
+			var attributePaths []path.Path
+			general := false
+			for _, d := range diags {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attributePaths = append(attributePaths, withPath.Path())
+				} else {
+					general = true
+				}
+			}
+
+			if len(attributePaths) != len(tt.wantAttributePaths) {
+				t.Fatalf("got attribute errors at %v, want %v", attributePaths, tt.wantAttributePaths)
+			}
+			for i := range attributePaths {
+				if !attributePaths[i].Equal(tt.wantAttributePaths[i]) {
+					t.Errorf("got attribute error at %s, want %s", attributePaths[i], tt.wantAttributePaths[i])
+				}
+			}
+			if general != tt.wantGeneral {
+				t.Errorf("got general error %t, want %t", general, tt.wantGeneral)
+			}
+		})
+	}
+}
@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FileResource{}
+var _ resource.ResourceWithImportState = &FileResource{}
+var _ resource.ResourceWithModifyPlan = &FileResource{}
+
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+// FileResource defines the resource implementation.
+type FileResource struct {
+	client *client.VapiClient
+}
+
+// FileResourceModel describes the resource data model.
+type FileResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Source      types.String   `tfsdk:"source"`
+	Name        types.String   `tfsdk:"name"`
+	ContentHash types.String   `tfsdk:"content_hash"`
+	URL         types.String   `tfsdk:"url"`
+	Bytes       types.Int64    `tfsdk:"bytes"`
+	Mimetype    types.String   `tfsdk:"mimetype"`
+	Status      types.String   `tfsdk:"status"`
+	OrgID       types.String   `tfsdk:"org_id"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *FileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vapi file resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "File identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path of the local file to upload",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the file in Vapi. Defaults to the base name of source",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				MarkdownDescription: "Hex-encoded SHA-256 of the source file content. A change uploads the new content as a replacement file",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL the file is served from",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the file in bytes",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"mimetype": schema.StringAttribute{
+				MarkdownDescription: "MIME type Vapi detected for the file",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Processing status of the file (processing, done, failed)",
+				Computed:            true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization the file belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan hashes the source file so that changing its content plans a
+// replacement, while moving the same content to another path does not. It
+// also defaults name to the base name of source when it isn't configured.
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to hash when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var source, name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &source)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing name from the configuration reverts to the default
+	if name.IsNull() {
+		defaultName := types.StringUnknown()
+		if !source.IsUnknown() {
+			defaultName = types.StringValue(filepath.Base(source.ValueString()))
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), defaultName)...)
+	}
+
+	var stateContentHash types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("content_hash"), &stateContentHash)...)
+	}
+
+	// The path comes from another resource, so the content can't be compared until apply
+	if source.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringUnknown())...)
+		if !stateContentHash.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+		}
+		return
+	}
+
+	contentHash, err := fileContentHash(source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Unable to Read Source File",
+			fmt.Sprintf("Unable to hash %s: %s", source.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringValue(contentHash))...)
+
+	// The hash is null after an import, when there is no previous upload to compare against
+	if !stateContentHash.IsNull() && stateContentHash.ValueString() != contentHash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+}
+
+func (r *FileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	source := data.Source.ValueString()
+
+	// The planned hash must describe the content that is uploaded
+	contentHash, err := fileContentHash(source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read Source File", fmt.Sprintf("Unable to hash %s: %s", source, err))
+		return
+	}
+
+	if !data.ContentHash.IsUnknown() && data.ContentHash.ValueString() != contentHash {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Source File Changed",
+			fmt.Sprintf("%s changed between plan and apply. Run terraform apply again to upload the current content.", source),
+		)
+		return
+	}
+
+	name := data.Name.ValueString()
+	if data.Name.IsUnknown() || data.Name.IsNull() {
+		name = filepath.Base(source)
+	}
+
+	// Upload the file
+	uploadedFile, err := r.client.UploadFile(ctx, name, source)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to upload file", err, nil)
+		return
+	}
+
+	// Update the model with the uploaded file data
+	data.ID = types.StringValue(uploadedFile.ID)
+	data.ContentHash = types.StringValue(contentHash)
+	fileToModel(uploadedFile, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the file from the API
+	file, err := r.client.GetFile(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read file, got error: %s", err))
+		return
+	}
+
+	// Update the model with the file data
+	fileToModel(file, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state FileResourceModel
+
+	// Read Terraform prior state data to tell whether the file was renamed
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Content changes replace the file, so an in-place update can only rename
+	// it. Other changes, such as moving the source, only need fresh API data.
+	// The default name is only known once source is
+	if data.Name.IsUnknown() {
+		data.Name = types.StringValue(filepath.Base(data.Source.ValueString()))
+	}
+
+	var file *client.File
+	var err error
+	if !data.Name.Equal(state.Name) {
+		file, err = r.client.RenameFile(ctx, data.ID.ValueString(), data.Name.ValueString())
+		if err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to rename file", err, nil)
+			return
+		}
+	} else {
+		file, err = r.client.GetFile(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read file, got error: %s", err))
+			return
+		}
+	}
+
+	// Update the model with the file data
+	fileToModel(file, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the file, treating one that is already gone as deleted
+	err := r.client.DeleteFile(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete file, got error: %s", err))
+		return
+	}
+}
+
+func (r *FileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// fileToModel copies the API response into the Terraform model. The source
+// path and content hash are local to Terraform and keep their prior values.
+func fileToModel(file *client.File, data *FileResourceModel) {
+	data.Name = types.StringValue(file.Name)
+	data.URL = stringValueOrNull(file.URL)
+	data.Mimetype = stringValueOrNull(file.Mimetype)
+	data.Status = stringValueOrNull(file.Status)
+	data.OrgID = types.StringValue(file.OrgID)
+	data.CreatedAt = types.StringValue(file.CreatedAt)
+	data.UpdatedAt = types.StringValue(file.UpdatedAt)
+
+	if file.Bytes != nil {
+		data.Bytes = types.Int64Value(*file.Bytes)
+	} else {
+		data.Bytes = types.Int64Null()
+	}
+}
+
+// fileContentHash returns the hex-encoded SHA-256 of the file at path,
+// matching Terraform's filesha256 function
+func fileContentHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
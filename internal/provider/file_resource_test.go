@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// resourceData builds plan, state or config data for s from values, leaving
+// every other attribute null. A nil values map builds null data, such as the
+// state of a resource that is being created.
+func resourceData(t *testing.T, s schema.Schema, values map[string]attr.Value) tfsdk.Plan {
+	t.Helper()
+
+	ctx := context.Background()
+	data := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	for name, value := range values {
+		if diags := data.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("set %s: %v", name, diags)
+		}
+	}
+
+	return data
+}
+
+func TestFileResourceModifyPlan(t *testing.T) {
+	dir := t.TempDir()
+	catalog := filepath.Join(dir, "catalog.csv")
+	if err := os.WriteFile(catalog, []byte("sku,price\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	moved := filepath.Join(dir, "moved.csv")
+	if err := os.WriteFile(moved, []byte("sku,price\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	catalogHash, err := fileContentHash(catalog)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		config          map[string]attr.Value
+		state           map[string]attr.Value
+		wantName        types.String
+		wantContentHash types.String
+		wantReplace     bool
+		wantError       bool
+	}{
+		{
+			name:            "create with the default name",
+			config:          map[string]attr.Value{"source": types.StringValue(catalog)},
+			wantName:        types.StringValue("catalog.csv"),
+			wantContentHash: types.StringValue(catalogHash),
+		},
+		{
+			name: "create with a name",
+			config: map[string]attr.Value{
+				"source": types.StringValue(catalog),
+				"name":   types.StringValue("Price list"),
+			},
+			wantName:        types.StringValue("Price list"),
+			wantContentHash: types.StringValue(catalogHash),
+		},
+		{
+			name:   "name removed from the configuration",
+			config: map[string]attr.Value{"source": types.StringValue(catalog)},
+			state: map[string]attr.Value{
+				"source":       types.StringValue(catalog),
+				"name":         types.StringValue("Price list"),
+				"content_hash": types.StringValue(catalogHash),
+			},
+			wantName:        types.StringValue("catalog.csv"),
+			wantContentHash: types.StringValue(catalogHash),
+		},
+		{
+			name:   "content changed",
+			config: map[string]attr.Value{"source": types.StringValue(catalog)},
+			state: map[string]attr.Value{
+				"source":       types.StringValue(catalog),
+				"name":         types.StringValue("catalog.csv"),
+				"content_hash": types.StringValue("0000"),
+			},
+			wantName:        types.StringValue("catalog.csv"),
+			wantContentHash: types.StringValue(catalogHash),
+			wantReplace:     true,
+		},
+		{
+			name: "same content moved to another path",
+			config: map[string]attr.Value{
+				"source": types.StringValue(moved),
+				"name":   types.StringValue("catalog.csv"),
+			},
+			state: map[string]attr.Value{
+				"source":       types.StringValue(catalog),
+				"name":         types.StringValue("catalog.csv"),
+				"content_hash": types.StringValue(catalogHash),
+			},
+			wantName:        types.StringValue("catalog.csv"),
+			wantContentHash: types.StringValue(catalogHash),
+		},
+		{
+			name:   "imported file",
+			config: map[string]attr.Value{"source": types.StringValue(catalog)},
+			state: map[string]attr.Value{
+				"name": types.StringValue("catalog.csv"),
+			},
+			wantName:        types.StringValue("catalog.csv"),
+			wantContentHash: types.StringValue(catalogHash),
+		},
+		{
+			name:   "source not known until apply",
+			config: map[string]attr.Value{"source": types.StringUnknown()},
+			state: map[string]attr.Value{
+				"source":       types.StringValue(catalog),
+				"name":         types.StringValue("catalog.csv"),
+				"content_hash": types.StringValue(catalogHash),
+			},
+			wantName:        types.StringUnknown(),
+			wantContentHash: types.StringUnknown(),
+			wantReplace:     true,
+		},
+		{
+			name:      "missing source file",
+			config:    map[string]attr.Value{"source": types.StringValue(filepath.Join(dir, "missing.csv"))},
+			wantError: true,
+		},
+	}
+
+	r := NewFileResource().(*FileResource)
+	s := resourceSchema(t, r)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// Computed attributes the configuration leaves out are unknown in the plan
+			planned := map[string]attr.Value{"name": types.StringUnknown(), "content_hash": types.StringUnknown()}
+			for name, value := range tt.config {
+				planned[name] = value
+			}
+			plan := resourceData(t, s, planned)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config(resourceData(t, s, tt.config)),
+				Plan:   plan,
+				State:  tfsdk.State(resourceData(t, s, nil)),
+			}
+			if tt.state != nil {
+				req.State = tfsdk.State(resourceData(t, s, tt.state))
+			}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+
+			r.ModifyPlan(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("got diagnostics %v, want error %t", resp.Diagnostics, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+
+			var name, contentHash types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("content_hash"), &contentHash)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("plan diagnostics: %v", resp.Diagnostics)
+			}
+
+			if !name.Equal(tt.wantName) {
+				t.Errorf("got name %s, want %s", name, tt.wantName)
+			}
+			if !contentHash.Equal(tt.wantContentHash) {
+				t.Errorf("got content_hash %s, want %s", contentHash, tt.wantContentHash)
+			}
+
+			if replace := resp.RequiresReplace.Contains(path.Root("content_hash")); replace != tt.wantReplace {
+				t.Errorf("got replace %t, want %t", replace, tt.wantReplace)
+			}
+		})
+	}
+}
+
+func TestFileToModel(t *testing.T) {
+	size := int64(42)
+
+	tests := []struct {
+		name string
+		file *client.File
+		want FileResourceModel
+	}{
+		{
+			name: "every field",
+			file: &client.File{
+				Name:      "catalog.csv",
+				URL:       "https://storage.vapi.ai/catalog.csv",
+				Bytes:     &size,
+				Mimetype:  "text/csv",
+				Status:    "done",
+				OrgID:     "org-1",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				UpdatedAt: "2024-01-02T00:00:00Z",
+			},
+			want: FileResourceModel{
+				Name:      types.StringValue("catalog.csv"),
+				URL:       types.StringValue("https://storage.vapi.ai/catalog.csv"),
+				Bytes:     types.Int64Value(42),
+				Mimetype:  types.StringValue("text/csv"),
+				Status:    types.StringValue("done"),
+				OrgID:     types.StringValue("org-1"),
+				CreatedAt: types.StringValue("2024-01-01T00:00:00Z"),
+				UpdatedAt: types.StringValue("2024-01-02T00:00:00Z"),
+			},
+		},
+		{
+			name: "still processing",
+			file: &client.File{
+				Name:      "catalog.csv",
+				OrgID:     "org-1",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				UpdatedAt: "2024-01-01T00:00:00Z",
+			},
+			want: FileResourceModel{
+				Name:      types.StringValue("catalog.csv"),
+				URL:       types.StringNull(),
+				Bytes:     types.Int64Null(),
+				Mimetype:  types.StringNull(),
+				Status:    types.StringNull(),
+				OrgID:     types.StringValue("org-1"),
+				CreatedAt: types.StringValue("2024-01-01T00:00:00Z"),
+				UpdatedAt: types.StringValue("2024-01-01T00:00:00Z"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data FileResourceModel
+			fileToModel(tt.file, &data)
+
+			if !reflect.DeepEqual(data, tt.want) {
+				t.Errorf("got %+v, want %+v", data, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseJSONObject decodes a JSON-encoded string attribute into a generic object.
+// Null and unknown values decode to nil.
+func parseJSONObject(value types.String) (map[string]interface{}, error) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil, nil
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &obj); err != nil {
+		return nil, fmt.Errorf("invalid JSON object: %w", err)
+	}
+
+	return obj, nil
+}
+
+// jsonStringValue encodes an API object as a JSON string attribute. The prior
+// value is kept when it is semantically equal, so that key order and whitespace
+// differences between the configuration and the API response don't show up as drift.
+func jsonStringValue(prior types.String, obj map[string]interface{}) (types.String, error) {
+	if len(obj) == 0 {
+		return types.StringNull(), nil
+	}
+
+	encoded, err := json.Marshal(obj)
+	if err != nil {
+		return types.StringNull(), fmt.Errorf("error encoding JSON object: %w", err)
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorObj interface{}
+		if err := json.Unmarshal([]byte(prior.ValueString()), &priorObj); err == nil {
+			normalized, err := json.Marshal(priorObj)
+			if err == nil && bytes.Equal(normalized, encoded) {
+				return prior, nil
+			}
+		}
+	}
+
+	return types.StringValue(string(encoded)), nil
+}
+
+// stringValueOrNull maps an empty API string to a null attribute value.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// stringValueOrPrior keeps the prior attribute value when the API omits a field,
+// such as a required credential reference some responses leave out.
+func stringValueOrPrior(prior types.String, value string) types.String {
+	if value == "" {
+		return prior
+	}
+
+	return types.StringValue(value)
+}
+
+// The refresh helpers below map optional API values back into state. Attributes
+// that are not in the prior state stay null even when the API reports a
+// server-side default, unless populate is set (e.g. right after an import), so
+// defaults don't show up as a permanent diff against the configuration.
+
+func refreshString(prior types.String, value string, populate bool) types.String {
+	if value == "" || (prior.IsNull() && !populate) {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+func refreshInt64(prior types.Int64, value *int, populate bool) types.Int64 {
+	if value == nil || (prior.IsNull() && !populate) {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*value))
+}
+
+func refreshFloat64(prior types.Float64, value *float64, populate bool) types.Float64 {
+	if value == nil || (prior.IsNull() && !populate) {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(*value)
+}
+
+func refreshBool(prior types.Bool, value *bool, populate bool) types.Bool {
+	if value == nil || (prior.IsNull() && !populate) {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(*value)
+}
+
+func refreshStringList(ctx context.Context, prior types.List, values []string, populate bool) (types.List, diag.Diagnostics) {
+	if prior.IsNull() && !populate {
+		return types.ListNull(types.StringType), nil
+	}
+
+	// Keep an explicitly empty list from the configuration instead of flipping it to null
+	if len(values) == 0 && (prior.IsNull() || len(prior.Elements()) > 0) {
+		return types.ListNull(types.StringType), nil
+	}
+
+	if values == nil {
+		values = []string{}
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+// orderedByKey returns a copy of items sorted into the order of keys, so that
+// list elements returned by the API in a different order match the prior
+// state. Items whose key is not in keys follow in their original order.
+func orderedByKey[T any](items []T, keys []string, key func(T) string) []T {
+	position := make(map[string]int, len(keys))
+	for i, k := range keys {
+		if _, seen := position[k]; !seen {
+			position[k] = i
+		}
+	}
+
+	ordered := append([]T(nil), items...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, iKnown := position[key(ordered[i])]
+		pj, jKnown := position[key(ordered[j])]
+		if !iKnown || !jKnown {
+			return iKnown && !jKnown
+		}
+		return pi < pj
+	})
+
+	return ordered
+}
+
+// objectAttribute returns the named attribute of a nested object, or a null
+// string when the object itself is null or unknown
+func objectAttribute(object types.Object, name string) attr.Value {
+	if object.IsNull() || object.IsUnknown() {
+		return types.StringNull()
+	}
+
+	if value, ok := object.Attributes()[name]; ok {
+		return value
+	}
+
+	return types.StringNull()
+}
+
+// nestedNullFields returns the API paths of the attributes of a nested object
+// that are set in the prior state but removed from the plan while the object
+// itself is kept, e.g. "server.timeoutSeconds". fields maps attribute names to
+// API field names.
+func nestedNullFields(parent string, prior, planned types.Object, fields map[string]string) []string {
+	if prior.IsNull() || prior.IsUnknown() || planned.IsNull() || planned.IsUnknown() {
+		return nil
+	}
+
+	attributes := make([]string, 0, len(fields))
+	for attribute := range fields {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	priorAttributes := prior.Attributes()
+	plannedAttributes := planned.Attributes()
+
+	var nullFields []string
+	for _, attribute := range attributes {
+		priorValue, plannedValue := priorAttributes[attribute], plannedAttributes[attribute]
+		if priorValue != nil && plannedValue != nil && !priorValue.IsNull() && plannedValue.IsNull() {
+			nullFields = append(nullFields, parent+"."+fields[attribute])
+		}
+	}
+
+	return nullFields
+}
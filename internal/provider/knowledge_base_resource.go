@@ -0,0 +1,711 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KnowledgeBaseResource{}
+var _ resource.ResourceWithImportState = &KnowledgeBaseResource{}
+var _ resource.ResourceWithValidateConfig = &KnowledgeBaseResource{}
+
+// knowledgeBaseFieldRenames maps Vapi field paths to differently named knowledge base attributes
+var knowledgeBaseFieldRenames = map[string]string{
+	"provider":                        "provider_type",
+	"createPlan.chunkPlans.0.fileIds": "file_ids",
+	"createPlan.chunkPlans.0.targetSplitsPerChunk": "chunking.target_splits_per_chunk",
+	"createPlan.chunkPlans.0.splitDelimiters":      "chunking.split_delimiters",
+	"createPlan.chunkPlans.0.rebalanceChunks":      "chunking.rebalance_chunks",
+}
+
+const (
+	knowledgeBaseProviderTrieve = "trieve"
+	knowledgeBaseProviderCustom = "custom-knowledge-base"
+)
+
+// knowledgeBaseSearchTypes lists the ways a Trieve knowledge base can be searched
+var knowledgeBaseSearchTypes = []string{"fulltext", "semantic", "hybrid", "bm25"}
+
+// knowledgeBaseTrieveAttributes are the attributes only Trieve knowledge bases accept
+var knowledgeBaseTrieveAttributes = []string{"file_ids", "search_plan", "chunking"}
+
+func NewKnowledgeBaseResource() resource.Resource {
+	return &KnowledgeBaseResource{}
+}
+
+// KnowledgeBaseResource defines the resource implementation.
+type KnowledgeBaseResource struct {
+	client *client.VapiClient
+}
+
+// KnowledgeBaseResourceModel describes the resource data model.
+type KnowledgeBaseResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProviderType types.String `tfsdk:"provider_type"`
+	Name         types.String `tfsdk:"name"`
+	FileIDs      types.List   `tfsdk:"file_ids"`
+	SearchPlan   types.Object `tfsdk:"search_plan"`
+	Chunking     types.Object `tfsdk:"chunking"`
+	Server       types.Object `tfsdk:"server"`
+	OrgID        types.String `tfsdk:"org_id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// KnowledgeBaseSearchPlanModel describes how a Trieve knowledge base is searched
+type KnowledgeBaseSearchPlanModel struct {
+	SearchType      types.String  `tfsdk:"search_type"`
+	TopK            types.Int64   `tfsdk:"top_k"`
+	RemoveStopWords types.Bool    `tfsdk:"remove_stop_words"`
+	ScoreThreshold  types.Float64 `tfsdk:"score_threshold"`
+}
+
+// KnowledgeBaseChunkingModel describes how files are split into chunks
+type KnowledgeBaseChunkingModel struct {
+	TargetSplitsPerChunk types.Int64 `tfsdk:"target_splits_per_chunk"`
+	SplitDelimiters      types.List  `tfsdk:"split_delimiters"`
+	RebalanceChunks      types.Bool  `tfsdk:"rebalance_chunks"`
+}
+
+// KnowledgeBaseServerModel describes the server a custom knowledge base queries
+type KnowledgeBaseServerModel struct {
+	URL            types.String `tfsdk:"url"`
+	Secret         types.String `tfsdk:"secret"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+}
+
+func knowledgeBaseSearchPlanAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"search_type":       types.StringType,
+		"top_k":             types.Int64Type,
+		"remove_stop_words": types.BoolType,
+		"score_threshold":   types.Float64Type,
+	}
+}
+
+func knowledgeBaseChunkingAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"target_splits_per_chunk": types.Int64Type,
+		"split_delimiters":        types.ListType{ElemType: types.StringType},
+		"rebalance_chunks":        types.BoolType,
+	}
+}
+
+func knowledgeBaseServerAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url":             types.StringType,
+		"secret":          types.StringType,
+		"timeout_seconds": types.Int64Type,
+	}
+}
+
+func (r *KnowledgeBaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_knowledge_base"
+}
+
+func (r *KnowledgeBaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vapi knowledge base resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Knowledge base identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Knowledge base provider (trieve, custom-knowledge-base)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(knowledgeBaseProviderTrieve, knowledgeBaseProviderCustom),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name for the knowledge base",
+				Optional:            true,
+			},
+			"file_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the uploaded files the knowledge base is built from. Trieve only",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"search_plan": schema.SingleNestedAttribute{
+				MarkdownDescription: "How the knowledge base is searched. Trieve only",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"search_type": schema.StringAttribute{
+						MarkdownDescription: "Search method (fulltext, semantic, hybrid, bm25)",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(knowledgeBaseSearchTypes...),
+						},
+					},
+					"top_k": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of chunks returned per search",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"remove_stop_words": schema.BoolAttribute{
+						MarkdownDescription: "Whether stop words are removed from the query",
+						Optional:            true,
+					},
+					"score_threshold": schema.Float64Attribute{
+						MarkdownDescription: "Minimum relevance score of returned chunks, between 0 and 1",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
+					},
+				},
+			},
+			"chunking": schema.SingleNestedAttribute{
+				MarkdownDescription: "How the files are split into chunks. Trieve only",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"target_splits_per_chunk": schema.Int64Attribute{
+						MarkdownDescription: "Number of splits grouped into one chunk",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"split_delimiters": schema.ListAttribute{
+						MarkdownDescription: "Delimiters the text is split on",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"rebalance_chunks": schema.BoolAttribute{
+						MarkdownDescription: "Whether chunks are rebalanced to similar sizes",
+						Optional:            true,
+					},
+				},
+			},
+			"server": schema.SingleNestedAttribute{
+				MarkdownDescription: "Server that answers knowledge base queries. Custom knowledge bases only",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL queries are sent to",
+						Required:            true,
+					},
+					"secret": schema.StringAttribute{
+						MarkdownDescription: "Secret sent in the X-Vapi-Secret header",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"timeout_seconds": schema.Int64Attribute{
+						MarkdownDescription: "How long to wait for the server to respond, between 1 and 300 seconds",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 300),
+						},
+					},
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization the knowledge base belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *KnowledgeBaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data KnowledgeBaseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProviderType.IsUnknown() || data.ProviderType.IsNull() {
+		return
+	}
+
+	provider := data.ProviderType.ValueString()
+
+	trieveValues := map[string]attr.Value{
+		"file_ids":    data.FileIDs,
+		"search_plan": data.SearchPlan,
+		"chunking":    data.Chunking,
+	}
+
+	switch provider {
+	case knowledgeBaseProviderTrieve:
+		for _, attribute := range []string{"file_ids", "search_plan"} {
+			if trieveValues[attribute].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Missing Attribute Configuration",
+					fmt.Sprintf("%s is required when provider_type is %q.", attribute, provider),
+				)
+			}
+		}
+
+		if !data.Server.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("server"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("server can't be set when provider_type is %q.", provider),
+			)
+		}
+	case knowledgeBaseProviderCustom:
+		if data.Server.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("server"),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("server is required when provider_type is %q.", provider),
+			)
+		}
+
+		for _, attribute := range knowledgeBaseTrieveAttributes {
+			if !trieveValues[attribute].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("%s can't be set when provider_type is %q.", attribute, provider),
+				)
+			}
+		}
+	}
+}
+
+func (r *KnowledgeBaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KnowledgeBaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KnowledgeBaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	knowledgeBase, diags := knowledgeBaseFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the knowledge base
+	createdKnowledgeBase, err := r.client.CreateKnowledgeBase(ctx, knowledgeBase)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create knowledge base", err, knowledgeBaseFieldRenames)
+		return
+	}
+
+	// Update the model with the created knowledge base data
+	data.ID = types.StringValue(createdKnowledgeBase.ID)
+	data.OrgID = types.StringValue(createdKnowledgeBase.OrgID)
+	data.CreatedAt = types.StringValue(createdKnowledgeBase.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdKnowledgeBase.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeBaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KnowledgeBaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the knowledge base from the API
+	knowledgeBase, err := r.client.GetKnowledgeBase(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read knowledge base, got error: %s", err))
+		return
+	}
+
+	// Update the model with the knowledge base data
+	resp.Diagnostics.Append(knowledgeBaseToModel(ctx, knowledgeBase, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeBaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data KnowledgeBaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state KnowledgeBaseResourceModel
+
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	knowledgeBase, diags := knowledgeBaseFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The provider can't change, and changes to the files or chunking replace the knowledge base
+	knowledgeBase.Provider = ""
+	knowledgeBase.CreatePlan = nil
+
+	knowledgeBase.NullFields = knowledgeBaseNullFields(state, data)
+
+	// Update the knowledge base
+	updatedKnowledgeBase, err := r.client.UpdateKnowledgeBase(ctx, data.ID.ValueString(), knowledgeBase)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update knowledge base", err, knowledgeBaseFieldRenames)
+		return
+	}
+
+	// Update the model with the updated knowledge base data
+	data.OrgID = types.StringValue(updatedKnowledgeBase.OrgID)
+	data.CreatedAt = types.StringValue(updatedKnowledgeBase.CreatedAt)
+	data.UpdatedAt = types.StringValue(updatedKnowledgeBase.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KnowledgeBaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KnowledgeBaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the knowledge base, treating one that is already gone as deleted
+	err := r.client.DeleteKnowledgeBase(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete knowledge base, got error: %s", err))
+		return
+	}
+}
+
+func (r *KnowledgeBaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// knowledgeBaseFromModel converts the Terraform model into the API payload
+func knowledgeBaseFromModel(ctx context.Context, data KnowledgeBaseResourceModel) (*client.KnowledgeBase, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	knowledgeBase := &client.KnowledgeBase{
+		Provider: data.ProviderType.ValueString(),
+		Name:     data.Name.ValueString(),
+	}
+
+	if !data.SearchPlan.IsNull() {
+		var searchPlanData KnowledgeBaseSearchPlanModel
+		diags.Append(data.SearchPlan.As(ctx, &searchPlanData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		knowledgeBase.SearchPlan = &client.KnowledgeBaseSearchPlan{
+			SearchType: searchPlanData.SearchType.ValueString(),
+		}
+
+		if !searchPlanData.TopK.IsNull() {
+			topK := int(searchPlanData.TopK.ValueInt64())
+			knowledgeBase.SearchPlan.TopK = &topK
+		}
+
+		if !searchPlanData.RemoveStopWords.IsNull() {
+			removeStopWords := searchPlanData.RemoveStopWords.ValueBool()
+			knowledgeBase.SearchPlan.RemoveStopWords = &removeStopWords
+		}
+
+		if !searchPlanData.ScoreThreshold.IsNull() {
+			scoreThreshold := searchPlanData.ScoreThreshold.ValueFloat64()
+			knowledgeBase.SearchPlan.ScoreThreshold = &scoreThreshold
+		}
+	}
+
+	// All files share one chunk plan, so they are split the same way
+	if !data.FileIDs.IsNull() {
+		var chunkPlan client.KnowledgeBaseChunkPlan
+		diags.Append(data.FileIDs.ElementsAs(ctx, &chunkPlan.FileIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if !data.Chunking.IsNull() {
+			var chunkingData KnowledgeBaseChunkingModel
+			diags.Append(data.Chunking.As(ctx, &chunkingData, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if !chunkingData.TargetSplitsPerChunk.IsNull() {
+				targetSplitsPerChunk := int(chunkingData.TargetSplitsPerChunk.ValueInt64())
+				chunkPlan.TargetSplitsPerChunk = &targetSplitsPerChunk
+			}
+
+			if !chunkingData.SplitDelimiters.IsNull() {
+				diags.Append(chunkingData.SplitDelimiters.ElementsAs(ctx, &chunkPlan.SplitDelimiters, false)...)
+				if diags.HasError() {
+					return nil, diags
+				}
+			}
+
+			if !chunkingData.RebalanceChunks.IsNull() {
+				rebalanceChunks := chunkingData.RebalanceChunks.ValueBool()
+				chunkPlan.RebalanceChunks = &rebalanceChunks
+			}
+		}
+
+		knowledgeBase.CreatePlan = &client.KnowledgeBaseCreatePlan{
+			Type:       "create",
+			ChunkPlans: []client.KnowledgeBaseChunkPlan{chunkPlan},
+		}
+	}
+
+	if !data.Server.IsNull() {
+		var serverData KnowledgeBaseServerModel
+		diags.Append(data.Server.As(ctx, &serverData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		knowledgeBase.Server = &client.KnowledgeBaseServer{
+			URL:    serverData.URL.ValueString(),
+			Secret: serverData.Secret.ValueString(),
+		}
+
+		if !serverData.TimeoutSeconds.IsNull() {
+			timeoutSeconds := int(serverData.TimeoutSeconds.ValueInt64())
+			knowledgeBase.Server.TimeoutSeconds = &timeoutSeconds
+		}
+	}
+
+	return knowledgeBase, diags
+}
+
+// knowledgeBaseNullFields returns the API fields that are set in the prior
+// state but removed from the plan, so the PATCH request clears them on the server.
+func knowledgeBaseNullFields(state, plan KnowledgeBaseResourceModel) []string {
+	candidates := []struct {
+		field   string
+		prior   attr.Value
+		planned attr.Value
+	}{
+		{"name", state.Name, plan.Name},
+		{"searchPlan", state.SearchPlan, plan.SearchPlan},
+		{"server", state.Server, plan.Server},
+	}
+
+	var nullFields []string
+	for _, candidate := range candidates {
+		if !candidate.prior.IsNull() && candidate.planned.IsNull() {
+			nullFields = append(nullFields, candidate.field)
+		}
+	}
+
+	nullFields = append(nullFields, nestedNullFields("searchPlan", state.SearchPlan, plan.SearchPlan, map[string]string{
+		"top_k":             "topK",
+		"remove_stop_words": "removeStopWords",
+		"score_threshold":   "scoreThreshold",
+	})...)
+	nullFields = append(nullFields, nestedNullFields("server", state.Server, plan.Server, map[string]string{
+		"secret":          "secret",
+		"timeout_seconds": "timeoutSeconds",
+	})...)
+
+	return nullFields
+}
+
+// knowledgeBaseToModel copies the API response into the Terraform model.
+// Optional attributes missing from the prior state are only populated after
+// an import, when the prior state holds nothing but the ID. The server secret
+// is never returned by the API, so its prior value is kept. When the response
+// omits the create plan, the files and chunking settings keep their prior values.
+func knowledgeBaseToModel(ctx context.Context, knowledgeBase *client.KnowledgeBase, data *KnowledgeBaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	importing := data.ProviderType.IsNull()
+
+	data.ProviderType = types.StringValue(knowledgeBase.Provider)
+	data.Name = refreshString(data.Name, knowledgeBase.Name, importing)
+	data.OrgID = types.StringValue(knowledgeBase.OrgID)
+	data.CreatedAt = types.StringValue(knowledgeBase.CreatedAt)
+	data.UpdatedAt = types.StringValue(knowledgeBase.UpdatedAt)
+
+	if knowledgeBase.SearchPlan == nil {
+		data.SearchPlan = types.ObjectNull(knowledgeBaseSearchPlanAttrTypes())
+	} else {
+		var prior KnowledgeBaseSearchPlanModel
+		if !data.SearchPlan.IsNull() {
+			diags.Append(data.SearchPlan.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		searchPlanObject, objectDiags := types.ObjectValueFrom(ctx, knowledgeBaseSearchPlanAttrTypes(), KnowledgeBaseSearchPlanModel{
+			SearchType:      types.StringValue(knowledgeBase.SearchPlan.SearchType),
+			TopK:            refreshInt64(prior.TopK, knowledgeBase.SearchPlan.TopK, importing),
+			RemoveStopWords: refreshBool(prior.RemoveStopWords, knowledgeBase.SearchPlan.RemoveStopWords, importing),
+			ScoreThreshold:  refreshFloat64(prior.ScoreThreshold, knowledgeBase.SearchPlan.ScoreThreshold, importing),
+		})
+		diags.Append(objectDiags...)
+		data.SearchPlan = searchPlanObject
+	}
+
+	if knowledgeBase.CreatePlan != nil && len(knowledgeBase.CreatePlan.ChunkPlans) > 0 {
+		// Knowledge bases created elsewhere may have several chunk plans. Their
+		// files are merged, and the first plan's settings stand for all of them.
+		var fileIDs []string
+		for _, chunkPlan := range knowledgeBase.CreatePlan.ChunkPlans {
+			fileIDs = append(fileIDs, chunkPlan.FileIDs...)
+		}
+
+		var listDiags diag.Diagnostics
+		data.FileIDs, listDiags = refreshStringList(ctx, data.FileIDs, fileIDs, importing)
+		diags.Append(listDiags...)
+
+		chunkPlan := knowledgeBase.CreatePlan.ChunkPlans[0]
+		hasChunking := chunkPlan.TargetSplitsPerChunk != nil || len(chunkPlan.SplitDelimiters) > 0 || chunkPlan.RebalanceChunks != nil
+
+		if data.Chunking.IsNull() && !(importing && hasChunking) {
+			data.Chunking = types.ObjectNull(knowledgeBaseChunkingAttrTypes())
+		} else {
+			var prior KnowledgeBaseChunkingModel
+			if !data.Chunking.IsNull() {
+				diags.Append(data.Chunking.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+				if diags.HasError() {
+					return diags
+				}
+			}
+
+			chunkingData := KnowledgeBaseChunkingModel{
+				TargetSplitsPerChunk: refreshInt64(prior.TargetSplitsPerChunk, chunkPlan.TargetSplitsPerChunk, importing),
+				RebalanceChunks:      refreshBool(prior.RebalanceChunks, chunkPlan.RebalanceChunks, importing),
+			}
+
+			chunkingData.SplitDelimiters, listDiags = refreshStringList(ctx, prior.SplitDelimiters, chunkPlan.SplitDelimiters, importing)
+			diags.Append(listDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			chunkingObject, objectDiags := types.ObjectValueFrom(ctx, knowledgeBaseChunkingAttrTypes(), chunkingData)
+			diags.Append(objectDiags...)
+			data.Chunking = chunkingObject
+		}
+	}
+
+	if knowledgeBase.Server == nil {
+		data.Server = types.ObjectNull(knowledgeBaseServerAttrTypes())
+	} else {
+		var prior KnowledgeBaseServerModel
+		if !data.Server.IsNull() {
+			diags.Append(data.Server.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		serverObject, objectDiags := types.ObjectValueFrom(ctx, knowledgeBaseServerAttrTypes(), KnowledgeBaseServerModel{
+			URL:            types.StringValue(knowledgeBase.Server.URL),
+			Secret:         prior.Secret,
+			TimeoutSeconds: refreshInt64(prior.TimeoutSeconds, knowledgeBase.Server.TimeoutSeconds, importing),
+		})
+		diags.Append(objectDiags...)
+		data.Server = serverObject
+	}
+
+	return diags
+}
@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateConfigErrors runs ValidateConfig on a configuration built from values
+// and returns the sorted attribute paths of the errors it reports
+func validateConfigErrors(t *testing.T, r resource.ResourceWithValidateConfig, values map[string]attr.Value) []string {
+	t.Helper()
+
+	req := resource.ValidateConfigRequest{Config: tfsdk.Config(resourceData(t, resourceSchema(t, r), values))}
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), req, &resp)
+
+	var paths []string
+	for _, d := range resp.Diagnostics.Errors() {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok {
+			t.Fatalf("error without an attribute path: %s: %s", d.Summary(), d.Detail())
+		}
+		paths = append(paths, withPath.Path().String())
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// testKnowledgeBaseModel returns a knowledge base model for provider with only the provider set
+func testKnowledgeBaseModel(provider string) KnowledgeBaseResourceModel {
+	return KnowledgeBaseResourceModel{
+		ProviderType: types.StringValue(provider),
+		Name:         types.StringNull(),
+		FileIDs:      types.ListNull(types.StringType),
+		SearchPlan:   types.ObjectNull(knowledgeBaseSearchPlanAttrTypes()),
+		Chunking:     types.ObjectNull(knowledgeBaseChunkingAttrTypes()),
+		Server:       types.ObjectNull(knowledgeBaseServerAttrTypes()),
+	}
+}
+
+func TestKnowledgeBaseValidateConfig(t *testing.T) {
+	fileIDs := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1")})
+	searchPlan := objectValue(t, knowledgeBaseSearchPlanAttrTypes(), map[string]attr.Value{"search_type": types.StringValue("hybrid")})
+	chunking := objectValue(t, knowledgeBaseChunkingAttrTypes(), map[string]attr.Value{"target_splits_per_chunk": types.Int64Value(20)})
+	server := objectValue(t, knowledgeBaseServerAttrTypes(), map[string]attr.Value{"url": types.StringValue("https://example.com/kb")})
+
+	tests := []struct {
+		name   string
+		config map[string]attr.Value
+		want   []string
+	}{
+		{
+			name: "trieve",
+			config: map[string]attr.Value{
+				"provider_type": types.StringValue("trieve"),
+				"file_ids":      fileIDs,
+				"search_plan":   searchPlan,
+				"chunking":      chunking,
+			},
+			want: nil,
+		},
+		{
+			name: "trieve without files or search plan",
+			config: map[string]attr.Value{
+				"provider_type": types.StringValue("trieve"),
+			},
+			want: []string{"file_ids", "search_plan"},
+		},
+		{
+			name: "trieve with a server",
+			config: map[string]attr.Value{
+				"provider_type": types.StringValue("trieve"),
+				"file_ids":      fileIDs,
+				"search_plan":   searchPlan,
+				"server":        server,
+			},
+			want: []string{"server"},
+		},
+		{
+			name: "custom",
+			config: map[string]attr.Value{
+				"provider_type": types.StringValue("custom-knowledge-base"),
+				"server":        server,
+			},
+			want: nil,
+		},
+		{
+			name: "custom without a server",
+			config: map[string]attr.Value{
+				"provider_type": types.StringValue("custom-knowledge-base"),
+			},
+			want: []string{"server"},
+		},
+		{
+			name: "custom with trieve settings",
+			config: map[string]attr.Value{
+				"provider_type": types.StringValue("custom-knowledge-base"),
+				"server":        server,
+				"file_ids":      fileIDs,
+				"search_plan":   searchPlan,
+				"chunking":      chunking,
+			},
+			want: []string{"chunking", "file_ids", "search_plan"},
+		},
+		{
+			name: "provider not known until apply",
+			config: map[string]attr.Value{
+				"provider_type": types.StringUnknown(),
+			},
+			want: nil,
+		},
+	}
+
+	r := NewKnowledgeBaseResource().(*KnowledgeBaseResource)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateConfigErrors(t, r, tt.config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got errors at %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKnowledgeBaseFromModel(t *testing.T) {
+	topK := 5
+	scoreThreshold := 0.2
+	targetSplitsPerChunk := 20
+	timeoutSeconds := 10
+	enabled := true
+
+	tests := []struct {
+		name string
+		data func(*KnowledgeBaseResourceModel)
+		want *client.KnowledgeBase
+	}{
+		{
+			name: "trieve",
+			data: func(data *KnowledgeBaseResourceModel) {
+				data.Name = types.StringValue("Product docs")
+				data.FileIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1"), types.StringValue("file-2")})
+				data.SearchPlan = objectValue(t, knowledgeBaseSearchPlanAttrTypes(), map[string]attr.Value{
+					"search_type":       types.StringValue("hybrid"),
+					"top_k":             types.Int64Value(5),
+					"remove_stop_words": types.BoolValue(true),
+					"score_threshold":   types.Float64Value(0.2),
+				})
+				data.Chunking = objectValue(t, knowledgeBaseChunkingAttrTypes(), map[string]attr.Value{
+					"target_splits_per_chunk": types.Int64Value(20),
+					"split_delimiters":        types.ListValueMust(types.StringType, []attr.Value{types.StringValue(".")}),
+					"rebalance_chunks":        types.BoolValue(true),
+				})
+			},
+			want: &client.KnowledgeBase{
+				Provider: "trieve",
+				Name:     "Product docs",
+				SearchPlan: &client.KnowledgeBaseSearchPlan{
+					SearchType:      "hybrid",
+					TopK:            &topK,
+					RemoveStopWords: &enabled,
+					ScoreThreshold:  &scoreThreshold,
+				},
+				CreatePlan: &client.KnowledgeBaseCreatePlan{
+					Type: "create",
+					ChunkPlans: []client.KnowledgeBaseChunkPlan{{
+						FileIDs:              []string{"file-1", "file-2"},
+						TargetSplitsPerChunk: &targetSplitsPerChunk,
+						SplitDelimiters:      []string{"."},
+						RebalanceChunks:      &enabled,
+					}},
+				},
+			},
+		},
+		{
+			name: "trieve without chunking",
+			data: func(data *KnowledgeBaseResourceModel) {
+				data.FileIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1")})
+				data.SearchPlan = objectValue(t, knowledgeBaseSearchPlanAttrTypes(), map[string]attr.Value{
+					"search_type": types.StringValue("semantic"),
+				})
+			},
+			want: &client.KnowledgeBase{
+				Provider:   "trieve",
+				SearchPlan: &client.KnowledgeBaseSearchPlan{SearchType: "semantic"},
+				CreatePlan: &client.KnowledgeBaseCreatePlan{
+					Type:       "create",
+					ChunkPlans: []client.KnowledgeBaseChunkPlan{{FileIDs: []string{"file-1"}}},
+				},
+			},
+		},
+		{
+			name: "custom",
+			data: func(data *KnowledgeBaseResourceModel) {
+				data.ProviderType = types.StringValue("custom-knowledge-base")
+				data.Server = objectValue(t, knowledgeBaseServerAttrTypes(), map[string]attr.Value{
+					"url":             types.StringValue("https://example.com/kb"),
+					"secret":          types.StringValue("secret"),
+					"timeout_seconds": types.Int64Value(10),
+				})
+			},
+			want: &client.KnowledgeBase{
+				Provider: "custom-knowledge-base",
+				Server: &client.KnowledgeBaseServer{
+					URL:            "https://example.com/kb",
+					Secret:         "secret",
+					TimeoutSeconds: &timeoutSeconds,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testKnowledgeBaseModel("trieve")
+			tt.data(&data)
+
+			got, diags := knowledgeBaseFromModel(context.Background(), data)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKnowledgeBaseNullFields(t *testing.T) {
+	searchPlan := func(values map[string]attr.Value) types.Object {
+		values["search_type"] = types.StringValue("hybrid")
+		return objectValue(t, knowledgeBaseSearchPlanAttrTypes(), values)
+	}
+	server := func(values map[string]attr.Value) types.Object {
+		values["url"] = types.StringValue("https://example.com/kb")
+		return objectValue(t, knowledgeBaseServerAttrTypes(), values)
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		state    func(*KnowledgeBaseResourceModel)
+		plan     func(*KnowledgeBaseResourceModel)
+		want     []string
+	}{
+		{
+			name:     "nothing removed",
+			provider: "trieve",
+			state: func(data *KnowledgeBaseResourceModel) {
+				data.SearchPlan = searchPlan(map[string]attr.Value{"top_k": types.Int64Value(5)})
+			},
+			plan: func(data *KnowledgeBaseResourceModel) {
+				data.SearchPlan = searchPlan(map[string]attr.Value{"top_k": types.Int64Value(10)})
+			},
+			want: nil,
+		},
+		{
+			name:     "search plan fields",
+			provider: "trieve",
+			state: func(data *KnowledgeBaseResourceModel) {
+				data.Name = types.StringValue("Product docs")
+				data.SearchPlan = searchPlan(map[string]attr.Value{
+					"top_k":           types.Int64Value(5),
+					"score_threshold": types.Float64Value(0.2),
+				})
+			},
+			plan: func(data *KnowledgeBaseResourceModel) {
+				data.SearchPlan = searchPlan(map[string]attr.Value{})
+			},
+			want: []string{"name", "searchPlan.scoreThreshold", "searchPlan.topK"},
+		},
+		{
+			name:     "server fields",
+			provider: "custom-knowledge-base",
+			state: func(data *KnowledgeBaseResourceModel) {
+				data.Server = server(map[string]attr.Value{
+					"secret":          types.StringValue("secret"),
+					"timeout_seconds": types.Int64Value(10),
+				})
+			},
+			plan: func(data *KnowledgeBaseResourceModel) {
+				data.Server = server(map[string]attr.Value{})
+			},
+			want: []string{"server.secret", "server.timeoutSeconds"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, plan := testKnowledgeBaseModel(tt.provider), testKnowledgeBaseModel(tt.provider)
+			tt.state(&state)
+			tt.plan(&plan)
+
+			if got := knowledgeBaseNullFields(state, plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKnowledgeBaseToModel(t *testing.T) {
+	topK := 5
+	targetSplitsPerChunk := 20
+	timeoutSeconds := 10
+
+	tests := []struct {
+		name          string
+		knowledgeBase *client.KnowledgeBase
+		prior         func(*KnowledgeBaseResourceModel)
+		want          func(*KnowledgeBaseResourceModel)
+	}{
+		{
+			name: "refresh keeps server defaults out of the state",
+			knowledgeBase: &client.KnowledgeBase{
+				Provider:   "trieve",
+				Name:       "Product docs",
+				SearchPlan: &client.KnowledgeBaseSearchPlan{SearchType: "hybrid", TopK: &topK},
+				CreatePlan: &client.KnowledgeBaseCreatePlan{
+					Type: "create",
+					ChunkPlans: []client.KnowledgeBaseChunkPlan{{
+						FileIDs:              []string{"file-1"},
+						TargetSplitsPerChunk: &targetSplitsPerChunk,
+					}},
+				},
+			},
+			prior: func(data *KnowledgeBaseResourceModel) {
+				data.FileIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1")})
+				data.SearchPlan = objectValue(t, knowledgeBaseSearchPlanAttrTypes(), map[string]attr.Value{
+					"search_type": types.StringValue("hybrid"),
+				})
+			},
+			want: func(data *KnowledgeBaseResourceModel) {
+				data.FileIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1")})
+				data.SearchPlan = objectValue(t, knowledgeBaseSearchPlanAttrTypes(), map[string]attr.Value{
+					"search_type": types.StringValue("hybrid"),
+				})
+			},
+		},
+		{
+			name: "response without a create plan keeps the files",
+			knowledgeBase: &client.KnowledgeBase{
+				Provider:   "trieve",
+				SearchPlan: &client.KnowledgeBaseSearchPlan{SearchType: "hybrid"},
+			},
+			prior: func(data *KnowledgeBaseResourceModel) {
+				data.FileIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1")})
+				data.Chunking = objectValue(t, knowledgeBaseChunkingAttrTypes(), map[string]attr.Value{
+					"target_splits_per_chunk": types.Int64Value(20),
+				})
+			},
+			want: func(data *KnowledgeBaseResourceModel) {
+				data.FileIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1")})
+				data.SearchPlan = objectValue(t, knowledgeBaseSearchPlanAttrTypes(), map[string]attr.Value{
+					"search_type": types.StringValue("hybrid"),
+				})
+				data.Chunking = objectValue(t, knowledgeBaseChunkingAttrTypes(), map[string]attr.Value{
+					"target_splits_per_chunk": types.Int64Value(20),
+				})
+			},
+		},
+		{
+			name: "import merges chunk plans and populates chunking",
+			knowledgeBase: &client.KnowledgeBase{
+				Provider:   "trieve",
+				Name:       "Product docs",
+				SearchPlan: &client.KnowledgeBaseSearchPlan{SearchType: "hybrid", TopK: &topK},
+				CreatePlan: &client.KnowledgeBaseCreatePlan{
+					Type: "create",
+					ChunkPlans: []client.KnowledgeBaseChunkPlan{
+						{FileIDs: []string{"file-1"}, TargetSplitsPerChunk: &targetSplitsPerChunk},
+						{FileIDs: []string{"file-2"}},
+					},
+				},
+			},
+			prior: func(data *KnowledgeBaseResourceModel) {
+				data.ProviderType = types.StringNull()
+			},
+			want: func(data *KnowledgeBaseResourceModel) {
+				data.Name = types.StringValue("Product docs")
+				data.FileIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("file-1"), types.StringValue("file-2")})
+				data.SearchPlan = objectValue(t, knowledgeBaseSearchPlanAttrTypes(), map[string]attr.Value{
+					"search_type": types.StringValue("hybrid"),
+					"top_k":       types.Int64Value(5),
+				})
+				data.Chunking = objectValue(t, knowledgeBaseChunkingAttrTypes(), map[string]attr.Value{
+					"target_splits_per_chunk": types.Int64Value(20),
+				})
+			},
+		},
+		{
+			name: "custom server secret is kept",
+			knowledgeBase: &client.KnowledgeBase{
+				Provider: "custom-knowledge-base",
+				Server:   &client.KnowledgeBaseServer{URL: "https://example.com/kb", TimeoutSeconds: &timeoutSeconds},
+			},
+			prior: func(data *KnowledgeBaseResourceModel) {
+				data.ProviderType = types.StringValue("custom-knowledge-base")
+				data.Server = objectValue(t, knowledgeBaseServerAttrTypes(), map[string]attr.Value{
+					"url":    types.StringValue("https://example.com/kb"),
+					"secret": types.StringValue("secret"),
+				})
+			},
+			want: func(data *KnowledgeBaseResourceModel) {
+				data.ProviderType = types.StringValue("custom-knowledge-base")
+				data.Server = objectValue(t, knowledgeBaseServerAttrTypes(), map[string]attr.Value{
+					"url":    types.StringValue("https://example.com/kb"),
+					"secret": types.StringValue("secret"),
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testKnowledgeBaseModel("trieve")
+			tt.prior(&data)
+
+			if diags := knowledgeBaseToModel(context.Background(), tt.knowledgeBase, &data); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			want := testKnowledgeBaseModel("trieve")
+			tt.want(&want)
+			want.OrgID = types.StringValue("")
+			want.CreatedAt = types.StringValue("")
+			want.UpdatedAt = types.StringValue("")
+
+			if !reflect.DeepEqual(data, want) {
+				t.Errorf("got %+v, want %+v", data, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listFilter holds the name and creation time filters shared by the plural data sources
+type listFilter struct {
+	namePrefix    string
+	nameRegex     *regexp.Regexp
+	createdAfter  time.Time
+	createdBefore time.Time
+}
+
+// newListFilter parses the filter attributes, reporting invalid values as attribute errors
+func newListFilter(namePrefix, nameRegex, createdAfter, createdBefore types.String) (*listFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := &listFilter{
+		namePrefix: namePrefix.ValueString(),
+	}
+
+	if !nameRegex.IsNull() {
+		re, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", err.Error())
+		}
+		filter.nameRegex = re
+	}
+
+	for _, timestamp := range []struct {
+		attribute string
+		value     types.String
+		target    *time.Time
+	}{
+		{"created_after", createdAfter, &filter.createdAfter},
+		{"created_before", createdBefore, &filter.createdBefore},
+	} {
+		if timestamp.value.IsNull() {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, timestamp.value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(timestamp.attribute),
+				"Invalid Timestamp",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp such as \"2024-01-02T15:04:05Z\", got: %q.", timestamp.attribute, timestamp.value.ValueString()),
+			)
+			continue
+		}
+		*timestamp.target = parsed
+	}
+
+	return filter, diags
+}
+
+// matches reports whether an object with the given name and createdAt timestamp passes the filter
+func (f *listFilter) matches(name, createdAt string) bool {
+	if !strings.HasPrefix(name, f.namePrefix) {
+		return false
+	}
+
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+
+	if f.createdAfter.IsZero() && f.createdBefore.IsZero() {
+		return true
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false
+	}
+
+	if !f.createdAfter.IsZero() && !created.After(f.createdAfter) {
+		return false
+	}
+
+	if !f.createdBefore.IsZero() && !created.Before(f.createdBefore) {
+		return false
+	}
+
+	return true
+}
+
+// listOptions pushes the creation time filters down to the API so fewer pages are fetched
+func (f *listFilter) listOptions() client.ListOptions {
+	return client.ListOptions{
+		CreatedAfter:  f.createdAfter,
+		CreatedBefore: f.createdBefore,
+	}
+}
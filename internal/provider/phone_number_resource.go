@@ -6,17 +6,53 @@ import (
 
 	"terraform-provider-vapi/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PhoneNumberResource{}
 var _ resource.ResourceWithImportState = &PhoneNumberResource{}
+var _ resource.ResourceWithValidateConfig = &PhoneNumberResource{}
+var _ resource.ResourceWithModifyPlan = &PhoneNumberResource{}
+
+// phoneNumberFieldRenames maps Vapi field paths to differently named phone number attributes
+var phoneNumberFieldRenames = map[string]string{
+	"provider":               "provider_type",
+	"twilioAccountSid":       "twilio.account_sid",
+	"twilioAuthToken":        "twilio.auth_token",
+	"twilioApiKey":           "twilio.api_key",
+	"twilioApiSecret":        "twilio.api_secret",
+	"telnyxApiKey":           "telnyx.api_key",
+	"numberE164CheckEnabled": "byo_phone_number.number_e164_check_enabled",
+	"numberDesiredAreaCode":  "vapi.number_desired_area_code",
+	"sipUri":                 "vapi.sip_uri",
+}
+
+// phoneNumberProviders lists the telephony providers Vapi accepts
+var phoneNumberProviders = []string{"byo-phone-number", "telnyx", "twilio", "vapi", "vonage"}
+
+// phoneNumberProviderBlocks maps each provider to the attribute holding its configuration
+var phoneNumberProviderBlocks = []struct {
+	provider  string
+	attribute string
+}{
+	{"twilio", "twilio"},
+	{"vonage", "vonage"},
+	{"telnyx", "telnyx"},
+	{"byo-phone-number", "byo_phone_number"},
+	{"vapi", "vapi"},
+}
 
 func NewPhoneNumberResource() resource.Resource {
 	return &PhoneNumberResource{}
@@ -29,21 +65,99 @@ type PhoneNumberResource struct {
 
 // PhoneNumberResourceModel describes the resource data model.
 type PhoneNumberResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Number              types.String `tfsdk:"number"`
-	Name                types.String `tfsdk:"name"`
-	AssistantID         types.String `tfsdk:"assistant_id"`
-	SquadID             types.String `tfsdk:"squad_id"`
-	ServerURL           types.String `tfsdk:"server_url"`
-	ServerURLSecret     types.String `tfsdk:"server_url_secret"`
-	ProviderType        types.String `tfsdk:"provider_type"`
-	TwilioAccountSid    types.String `tfsdk:"twilio_account_sid"`
-	TwilioAuthToken     types.String `tfsdk:"twilio_auth_token"`
-	VonageAPIKey        types.String `tfsdk:"vonage_api_key"`
-	VonageAPISecret     types.String `tfsdk:"vonage_api_secret"`
-	VonageApplicationID types.String `tfsdk:"vonage_application_id"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	ID                  types.String   `tfsdk:"id"`
+	Number              types.String   `tfsdk:"number"`
+	Name                types.String   `tfsdk:"name"`
+	AssistantID         types.String   `tfsdk:"assistant_id"`
+	SquadID             types.String   `tfsdk:"squad_id"`
+	WorkflowID          types.String   `tfsdk:"workflow_id"`
+	ServerURL           types.String   `tfsdk:"server_url"`
+	ServerURLSecret     types.String   `tfsdk:"server_url_secret"`
+	ProviderType        types.String   `tfsdk:"provider_type"`
+	Twilio              types.Object   `tfsdk:"twilio"`
+	Vonage              types.Object   `tfsdk:"vonage"`
+	Telnyx              types.Object   `tfsdk:"telnyx"`
+	BYOPhoneNumber      types.Object   `tfsdk:"byo_phone_number"`
+	Vapi                types.Object   `tfsdk:"vapi"`
+	TwilioAccountSid    types.String   `tfsdk:"twilio_account_sid"`
+	TwilioAuthToken     types.String   `tfsdk:"twilio_auth_token"`
+	VonageAPIKey        types.String   `tfsdk:"vonage_api_key"`
+	VonageAPISecret     types.String   `tfsdk:"vonage_api_secret"`
+	VonageApplicationID types.String   `tfsdk:"vonage_application_id"`
+	CredentialID        types.String   `tfsdk:"credential_id"`
+	SipURI              types.String   `tfsdk:"sip_uri"`
+	Status              types.String   `tfsdk:"status"`
+	OrgID               types.String   `tfsdk:"org_id"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	UpdatedAt           types.String   `tfsdk:"updated_at"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// PhoneNumberTwilioModel describes a number imported from Twilio
+type PhoneNumberTwilioModel struct {
+	AccountSid types.String `tfsdk:"account_sid"`
+	AuthToken  types.String `tfsdk:"auth_token"`
+	APIKey     types.String `tfsdk:"api_key"`
+	APISecret  types.String `tfsdk:"api_secret"`
+}
+
+// PhoneNumberVonageModel describes a number imported from Vonage
+type PhoneNumberVonageModel struct {
+	CredentialID types.String `tfsdk:"credential_id"`
+}
+
+// PhoneNumberTelnyxModel describes a number imported from Telnyx
+type PhoneNumberTelnyxModel struct {
+	CredentialID types.String `tfsdk:"credential_id"`
+	APIKey       types.String `tfsdk:"api_key"`
+}
+
+// PhoneNumberBYOModel describes a number routed through a bring-your-own SIP trunk
+type PhoneNumberBYOModel struct {
+	CredentialID           types.String `tfsdk:"credential_id"`
+	NumberE164CheckEnabled types.Bool   `tfsdk:"number_e164_check_enabled"`
+}
+
+// PhoneNumberVapiModel describes a free number provisioned by Vapi
+type PhoneNumberVapiModel struct {
+	NumberDesiredAreaCode types.String `tfsdk:"number_desired_area_code"`
+	SipURI                types.String `tfsdk:"sip_uri"`
+}
+
+func phoneNumberTwilioAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"account_sid": types.StringType,
+		"auth_token":  types.StringType,
+		"api_key":     types.StringType,
+		"api_secret":  types.StringType,
+	}
+}
+
+func phoneNumberVonageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"credential_id": types.StringType,
+	}
+}
+
+func phoneNumberTelnyxAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"credential_id": types.StringType,
+		"api_key":       types.StringType,
+	}
+}
+
+func phoneNumberBYOAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"credential_id":             types.StringType,
+		"number_e164_check_enabled": types.BoolType,
+	}
+}
+
+func phoneNumberVapiAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"number_desired_area_code": types.StringType,
+		"sip_uri":                  types.StringType,
+	}
 }
 
 func (r *PhoneNumberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,9 +178,11 @@ func (r *PhoneNumberResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"number": schema.StringAttribute{
-				MarkdownDescription: "Phone number in E.164 format (e.g., +1234567890)",
-				Required:            true,
+				MarkdownDescription: "Phone number in E.164 format (e.g., +1234567890). Required unless Vapi provisions the number",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -82,9 +198,16 @@ func (r *PhoneNumberResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Squad ID to handle calls on this number",
 				Optional:            true,
 			},
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "Workflow ID to handle calls on this number",
+				Optional:            true,
+			},
 			"server_url": schema.StringAttribute{
 				MarkdownDescription: "Server URL for webhooks",
 				Optional:            true,
+				Validators: []validator.String{
+					httpURL(),
+				},
 			},
 			"server_url_secret": schema.StringAttribute{
 				MarkdownDescription: "Secret for server URL webhook verification",
@@ -92,140 +215,474 @@ func (r *PhoneNumberResource) Schema(ctx context.Context, req resource.SchemaReq
 				Sensitive:           true,
 			},
 			"provider_type": schema.StringAttribute{
-				MarkdownDescription: "Telephony provider (twilio, vonage)",
+				MarkdownDescription: "Telephony provider (twilio, vonage, telnyx, byo-phone-number, vapi). Defaults to the provider of the configured provider block",
 				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(phoneNumberProviders...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"twilio": schema.SingleNestedAttribute{
+				MarkdownDescription: "Twilio account the number is imported from",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"account_sid": schema.StringAttribute{
+						MarkdownDescription: "Twilio Account SID",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							requiresReplaceIfChanged(),
+						},
+					},
+					"auth_token": schema.StringAttribute{
+						MarkdownDescription: "Twilio Auth Token",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"api_key": schema.StringAttribute{
+						MarkdownDescription: "Twilio API Key, used with api_secret instead of auth_token",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"api_secret": schema.StringAttribute{
+						MarkdownDescription: "Twilio API Secret",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"vonage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Vonage account the number is imported from",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"credential_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the Vapi credential holding the Vonage API key and secret",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							requiresReplaceIfChanged(),
+						},
+					},
+				},
+			},
+			"telnyx": schema.SingleNestedAttribute{
+				MarkdownDescription: "Telnyx account the number is imported from. Set credential_id, api_key or both",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"credential_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the Vapi credential holding the Telnyx API key",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							requiresReplaceIfChanged(),
+						},
+					},
+					"api_key": schema.StringAttribute{
+						MarkdownDescription: "Telnyx API Key",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"byo_phone_number": schema.SingleNestedAttribute{
+				MarkdownDescription: "Bring-your-own number routed through a SIP trunk credential",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"credential_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the SIP trunk credential the number is routed through",
+						Required:            true,
+					},
+					"number_e164_check_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether Vapi requires the number to be in E.164 format. Disable for extensions or other non-E.164 numbers",
+						Optional:            true,
+					},
+				},
+			},
+			"vapi": schema.SingleNestedAttribute{
+				MarkdownDescription: "Free number provisioned by Vapi. Leave number unset, Vapi assigns it",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"number_desired_area_code": schema.StringAttribute{
+						MarkdownDescription: "Area code to provision the number in (e.g., 415)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							requiresReplaceIfChanged(),
+						},
+					},
+					"sip_uri": schema.StringAttribute{
+						MarkdownDescription: "SIP URI for a SIP-only number (e.g., sip:support@example.sip.vapi.ai)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							requiresReplaceIfChanged(),
+						},
+					},
+				},
 			},
 			"twilio_account_sid": schema.StringAttribute{
 				MarkdownDescription: "Twilio Account SID (required if provider is twilio)",
+				DeprecationMessage:  "Use twilio.account_sid instead.",
 				Optional:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceIfChanged(),
+				},
 			},
 			"twilio_auth_token": schema.StringAttribute{
 				MarkdownDescription: "Twilio Auth Token (required if provider is twilio)",
+				DeprecationMessage:  "Use twilio.auth_token instead.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"vonage_api_key": schema.StringAttribute{
 				MarkdownDescription: "Vonage API Key (required if provider is vonage)",
+				DeprecationMessage:  "Vonage numbers authenticate with a credential. Use vonage.credential_id instead.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"vonage_api_secret": schema.StringAttribute{
 				MarkdownDescription: "Vonage API Secret (required if provider is vonage)",
+				DeprecationMessage:  "Vonage numbers authenticate with a credential. Use vonage.credential_id instead.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"vonage_application_id": schema.StringAttribute{
 				MarkdownDescription: "Vonage Application ID (required if provider is vonage)",
+				DeprecationMessage:  "Vonage numbers authenticate with a credential. Use vonage.credential_id instead.",
 				Optional:            true,
 			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the credential Vapi uses to reach the carrier, whichever provider block configured it",
+				Computed:            true,
+			},
+			"sip_uri": schema.StringAttribute{
+				MarkdownDescription: "SIP URI the number can be reached at, for pointing SIP trunks or other SIP clients at it",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the number (e.g., active, activating, blocked)",
+				Computed:            true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization the phone number belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Creation timestamp",
 				Computed:            true,
-				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
 				MarkdownDescription: "Last update timestamp",
 				Computed:            true,
-				Optional:            true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func (r *PhoneNumberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
+// phoneNumberProviderBlock returns the provider of the one provider block set
+// in data, or an empty string when none is set
+func phoneNumberProviderBlock(data PhoneNumberResourceModel) (provider string, attribute string) {
+	for _, block := range phoneNumberProviderBlocks {
+		if !phoneNumberBlockValue(data, block.attribute).IsNull() {
+			return block.provider, block.attribute
+		}
 	}
 
-	client, ok := req.ProviderData.(*client.VapiClient)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	return "", ""
+}
 
-		return
+// phoneNumberBlockValue returns the value of the provider block named attribute
+func phoneNumberBlockValue(data PhoneNumberResourceModel, attribute string) types.Object {
+	switch attribute {
+	case "twilio":
+		return data.Twilio
+	case "vonage":
+		return data.Vonage
+	case "telnyx":
+		return data.Telnyx
+	case "byo_phone_number":
+		return data.BYOPhoneNumber
+	default:
+		return data.Vapi
 	}
-
-	r.client = client
 }
 
-func (r *PhoneNumberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *PhoneNumberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data PhoneNumberResourceModel
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Convert Terraform model to API model
-	phoneNumber := &client.PhoneNumber{
-		Number: data.Number.ValueString(),
+	var routed []string
+	for _, route := range phoneNumberRoutes(data) {
+		if route.value.IsNull() {
+			continue
+		}
+
+		if len(routed) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(route.attribute),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Calls can only be handled by one of assistant_id, squad_id or workflow_id, %s conflicts with %s.", route.attribute, routed[0]),
+			)
+		}
+		routed = append(routed, route.attribute)
+	}
+
+	var configured []string
+	for _, block := range phoneNumberProviderBlocks {
+		if !phoneNumberBlockValue(data, block.attribute).IsNull() {
+			configured = append(configured, block.attribute)
+		}
+	}
+
+	if len(configured) > 1 {
+		for _, attribute := range configured[1:] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Only one provider block can be set, %s conflicts with %s.", attribute, configured[0]),
+			)
+		}
+		return
+	}
+
+	legacy := []struct {
+		attribute string
+		value     types.String
+	}{
+		{"twilio_account_sid", data.TwilioAccountSid},
+		{"twilio_auth_token", data.TwilioAuthToken},
+		{"vonage_api_key", data.VonageAPIKey},
+		{"vonage_api_secret", data.VonageAPISecret},
+		{"vonage_application_id", data.VonageApplicationID},
+	}
+
+	provider, attribute := phoneNumberProviderBlock(data)
+
+	if provider != "" {
+		for _, l := range legacy {
+			if !l.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(l.attribute),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("The deprecated %s attribute cannot be combined with the %s block.", l.attribute, attribute),
+				)
+			}
+		}
+
+		if !data.ProviderType.IsNull() && !data.ProviderType.IsUnknown() && data.ProviderType.ValueString() != provider {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("provider_type"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("provider_type is %q but the %s block configures a %q number.", data.ProviderType.ValueString(), attribute, provider),
+			)
+		}
+	}
+
+	if data.Number.IsNull() && provider != "vapi" && data.ProviderType.ValueString() != "vapi" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("number"),
+			"Missing Attribute Configuration",
+			"number is required unless the vapi block is set, Vapi only assigns the number of numbers it provisions.",
+		)
 	}
 
-	if !data.Name.IsNull() {
-		phoneNumber.Name = data.Name.ValueString()
+	// BYO numbers can opt out of the E.164 check, e.g. for extensions
+	e164Check := true
+	if !data.BYOPhoneNumber.IsNull() && !data.BYOPhoneNumber.IsUnknown() {
+		var byo PhoneNumberBYOModel
+		resp.Diagnostics.Append(data.BYOPhoneNumber.As(ctx, &byo, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		e164Check = byo.NumberE164CheckEnabled.IsNull() || byo.NumberE164CheckEnabled.ValueBool()
+	}
+
+	if e164Check && !data.Number.IsNull() && !data.Number.IsUnknown() && !e164Regexp.MatchString(data.Number.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("number"),
+			"Invalid Attribute Value Match",
+			fmt.Sprintf("Attribute number must be a phone number in E.164 format, such as +14155550123, got: %s", data.Number.ValueString()),
+		)
+	}
+
+	if !data.Telnyx.IsNull() && !data.Telnyx.IsUnknown() {
+		var telnyx PhoneNumberTelnyxModel
+		resp.Diagnostics.Append(data.Telnyx.As(ctx, &telnyx, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if telnyx.CredentialID.IsNull() && telnyx.APIKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("telnyx"),
+				"Missing Attribute Configuration",
+				"The telnyx block requires credential_id, api_key or both.",
+			)
+		}
+	}
+}
+
+// ModifyPlan derives provider_type from the configured provider block, so
+// switching blocks plans the matching provider instead of keeping the old one.
+func (r *PhoneNumberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to derive when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
 	}
 
-	if !data.AssistantID.IsNull() {
-		phoneNumber.AssistantID = data.AssistantID.ValueString()
+	var configProviderType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("provider_type"), &configProviderType)...)
+
+	var data PhoneNumberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !configProviderType.IsNull() {
+		return
 	}
 
-	if !data.SquadID.IsNull() {
-		phoneNumber.SquadID = data.SquadID.ValueString()
+	provider, _ := phoneNumberProviderBlock(data)
+	if provider == "" {
+		return
 	}
 
-	if !data.ServerURL.IsNull() {
-		phoneNumber.ServerURL = data.ServerURL.ValueString()
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("provider_type"), types.StringValue(provider))...)
+
+	// Attribute plan modifiers ran before the provider was derived, so flag the replacement here
+	if req.State.Raw.IsNull() {
+		return
 	}
 
-	if !data.ServerURLSecret.IsNull() {
-		phoneNumber.ServerURLSecret = data.ServerURLSecret.ValueString()
+	var stateProviderType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("provider_type"), &stateProviderType)...)
+
+	if !stateProviderType.IsNull() && stateProviderType.ValueString() != provider {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("provider_type"))
 	}
+}
 
-	if !data.ProviderType.IsNull() {
-		phoneNumber.Provider = data.ProviderType.ValueString()
+// phoneNumberRoute is an attribute that hands calls on the number to an assistant, squad or workflow
+type phoneNumberRoute struct {
+	attribute string
+	value     types.String
+}
+
+// phoneNumberRoutes returns the mutually exclusive call routing attributes of data
+func phoneNumberRoutes(data PhoneNumberResourceModel) []phoneNumberRoute {
+	return []phoneNumberRoute{
+		{"assistant_id", data.AssistantID},
+		{"squad_id", data.SquadID},
+		{"workflow_id", data.WorkflowID},
 	}
+}
 
-	if !data.TwilioAccountSid.IsNull() {
-		phoneNumber.TwilioAccountSid = data.TwilioAccountSid.ValueString()
+// requiresReplaceIfChanged replaces the phone number when a carrier
+// attribute changes from one value to another. Adding or removing the value
+// is left to the provider_type check, so moving from the deprecated flat
+// attributes to a provider block updates the number in place.
+func requiresReplaceIfChanged() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsNull()
+		},
+		"Changing this value requires the phone number to be replaced.",
+		"Changing this value requires the phone number to be replaced.",
+	)
+}
+
+func (r *PhoneNumberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
 	}
 
-	if !data.TwilioAuthToken.IsNull() {
-		phoneNumber.TwilioAuthToken = data.TwilioAuthToken.ValueString()
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
 	}
 
-	if !data.VonageAPIKey.IsNull() {
-		phoneNumber.VonageAPIKey = data.VonageAPIKey.ValueString()
+	r.client = client
+}
+
+func (r *PhoneNumberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PhoneNumberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.VonageAPISecret.IsNull() {
-		phoneNumber.VonageAPISecret = data.VonageAPISecret.ValueString()
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.VonageApplicationID.IsNull() {
-		phoneNumber.VonageApplicationID = data.VonageApplicationID.ValueString()
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Convert Terraform model to API model
+	phoneNumber, diags := phoneNumberFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create the phone number
-	createdPhoneNumber, err := r.client.CreatePhoneNumber(phoneNumber)
+	createdPhoneNumber, err := r.client.CreatePhoneNumber(ctx, phoneNumber)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create phone number, got error: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create phone number", err, phoneNumberFieldRenames)
 		return
 	}
 
 	// Update the model with the created phone number data
 	data.ID = types.StringValue(createdPhoneNumber.ID)
-	
-	// For now, set timestamp fields to null since VAPI API may not return them consistently
-	// This prevents "unknown value" errors while keeping the fields available
-	data.CreatedAt = types.StringNull()
-	data.UpdatedAt = types.StringNull()
+
+	// Vapi assigns the number of numbers it provisions, and the provider when none was configured
+	if data.Number.IsUnknown() {
+		data.Number = stringValueOrNull(createdPhoneNumber.Number)
+	}
+	if data.ProviderType.IsUnknown() {
+		data.ProviderType = stringValueOrNull(createdPhoneNumber.Provider)
+	}
+
+	data.CredentialID = stringValueOrNull(createdPhoneNumber.CredentialID)
+	data.SipURI = stringValueOrNull(createdPhoneNumber.SipURI)
+	data.Status = stringValueOrNull(createdPhoneNumber.Status)
+	data.OrgID = types.StringValue(createdPhoneNumber.OrgID)
+	data.CreatedAt = types.StringValue(createdPhoneNumber.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdPhoneNumber.UpdatedAt)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -241,29 +698,32 @@ func (r *PhoneNumberResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the phone number from the API
-	phoneNumber, err := r.client.GetPhoneNumber(data.ID.ValueString())
+	phoneNumber, err := r.client.GetPhoneNumber(ctx, data.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read phone number, got error: %s", err))
 		return
 	}
 
 	// Update the model with the phone number data
-	data.Number = types.StringValue(phoneNumber.Number)
-	data.Name = types.StringValue(phoneNumber.Name)
-	data.AssistantID = types.StringValue(phoneNumber.AssistantID)
-	data.SquadID = types.StringValue(phoneNumber.SquadID)
-	data.ServerURL = types.StringValue(phoneNumber.ServerURL)
-	data.ProviderType = types.StringValue(phoneNumber.Provider)
-	data.TwilioAccountSid = types.StringValue(phoneNumber.TwilioAccountSid)
-	data.VonageAPIKey = types.StringValue(phoneNumber.VonageAPIKey)
-	data.VonageApplicationID = types.StringValue(phoneNumber.VonageApplicationID)
-	// For now, set timestamp fields to null since VAPI API may not return them consistently
-	// This prevents "unknown value" errors while keeping the fields available
-	data.CreatedAt = types.StringNull()
-	data.UpdatedAt = types.StringNull()
-
-	// Note: Sensitive fields like secrets and tokens are not updated from API response for security
+	resp.Diagnostics.Append(phoneNumberToModel(ctx, phoneNumber, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -279,66 +739,60 @@ func (r *PhoneNumberResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Convert Terraform model to API model for update
-	// Note: Number field is immutable and should not be included in updates
-	phoneNumber := &client.PhoneNumber{}
-
-	if !data.Name.IsNull() {
-		phoneNumber.Name = data.Name.ValueString()
-	}
-
-	if !data.AssistantID.IsNull() {
-		phoneNumber.AssistantID = data.AssistantID.ValueString()
-	}
+	var state PhoneNumberResourceModel
 
-	if !data.SquadID.IsNull() {
-		phoneNumber.SquadID = data.SquadID.ValueString()
-	}
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	if !data.ServerURL.IsNull() {
-		phoneNumber.ServerURL = data.ServerURL.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.ServerURLSecret.IsNull() {
-		phoneNumber.ServerURLSecret = data.ServerURLSecret.ValueString()
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.ProviderType.IsNull() {
-		phoneNumber.Provider = data.ProviderType.ValueString()
-	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	if !data.TwilioAccountSid.IsNull() {
-		phoneNumber.TwilioAccountSid = data.TwilioAccountSid.ValueString()
+	// Convert Terraform model to API model for update
+	phoneNumber, diags := phoneNumberFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.TwilioAuthToken.IsNull() {
-		phoneNumber.TwilioAuthToken = data.TwilioAuthToken.ValueString()
-	}
+	// The number and its carrier can't change in place, RequiresReplace plans a new phone number instead
+	phoneNumber.Number = ""
 
-	if !data.VonageAPIKey.IsNull() {
-		phoneNumber.VonageAPIKey = data.VonageAPIKey.ValueString()
-	}
+	// Moving calls to another assistant, squad or workflow clears the previous
+	// one, like any other attribute removed from the configuration
+	phoneNumber.NullFields = phoneNumberNullFields(state, data)
 
-	if !data.VonageAPISecret.IsNull() {
-		phoneNumber.VonageAPISecret = data.VonageAPISecret.ValueString()
+	prior, diags := phoneNumberFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.VonageApplicationID.IsNull() {
-		phoneNumber.VonageApplicationID = data.VonageApplicationID.ValueString()
-	}
+	omitUnchangedPhoneNumberCredentials(phoneNumber, prior)
 
 	// Update the phone number
-	_, err := r.client.UpdatePhoneNumber(data.ID.ValueString(), phoneNumber)
+	updatedPhoneNumber, err := r.client.UpdatePhoneNumber(ctx, data.ID.ValueString(), phoneNumber)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update phone number, got error: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update phone number", err, phoneNumberFieldRenames)
 		return
 	}
 
 	// Update the model with the updated phone number data
-	// For now, set timestamp fields to null since VAPI API may not return them consistently
-	// This prevents "unknown value" errors while keeping the fields available
-	data.CreatedAt = types.StringNull()
-	data.UpdatedAt = types.StringNull()
+	data.CredentialID = stringValueOrNull(updatedPhoneNumber.CredentialID)
+	data.SipURI = stringValueOrNull(updatedPhoneNumber.SipURI)
+	data.Status = stringValueOrNull(updatedPhoneNumber.Status)
+	data.OrgID = types.StringValue(updatedPhoneNumber.OrgID)
+	data.CreatedAt = types.StringValue(updatedPhoneNumber.CreatedAt)
+	data.UpdatedAt = types.StringValue(updatedPhoneNumber.UpdatedAt)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -354,9 +808,18 @@ func (r *PhoneNumberResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	// Delete the phone number
-	err := r.client.DeletePhoneNumber(data.ID.ValueString())
-	if err != nil {
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the phone number, treating one that is already gone as deleted
+	err := r.client.DeletePhoneNumber(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete phone number, got error: %s", err))
 		return
 	}
@@ -365,3 +828,286 @@ func (r *PhoneNumberResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *PhoneNumberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// phoneNumberFromModel converts the Terraform model into the API payload. The
+// configured provider block decides which carrier fields are sent.
+func phoneNumberFromModel(ctx context.Context, data PhoneNumberResourceModel) (*client.PhoneNumber, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	phoneNumber := &client.PhoneNumber{
+		Number:              data.Number.ValueString(),
+		Name:                data.Name.ValueString(),
+		AssistantID:         data.AssistantID.ValueString(),
+		SquadID:             data.SquadID.ValueString(),
+		WorkflowID:          data.WorkflowID.ValueString(),
+		ServerURL:           data.ServerURL.ValueString(),
+		ServerURLSecret:     data.ServerURLSecret.ValueString(),
+		Provider:            data.ProviderType.ValueString(),
+		TwilioAccountSid:    data.TwilioAccountSid.ValueString(),
+		TwilioAuthToken:     data.TwilioAuthToken.ValueString(),
+		VonageAPIKey:        data.VonageAPIKey.ValueString(),
+		VonageAPISecret:     data.VonageAPISecret.ValueString(),
+		VonageApplicationID: data.VonageApplicationID.ValueString(),
+	}
+
+	provider, _ := phoneNumberProviderBlock(data)
+	if provider != "" {
+		phoneNumber.Provider = provider
+	}
+
+	switch provider {
+	case "twilio":
+		var twilio PhoneNumberTwilioModel
+		diags.Append(data.Twilio.As(ctx, &twilio, basetypes.ObjectAsOptions{})...)
+		phoneNumber.TwilioAccountSid = twilio.AccountSid.ValueString()
+		phoneNumber.TwilioAuthToken = twilio.AuthToken.ValueString()
+		phoneNumber.TwilioAPIKey = twilio.APIKey.ValueString()
+		phoneNumber.TwilioAPISecret = twilio.APISecret.ValueString()
+	case "vonage":
+		var vonage PhoneNumberVonageModel
+		diags.Append(data.Vonage.As(ctx, &vonage, basetypes.ObjectAsOptions{})...)
+		phoneNumber.CredentialID = vonage.CredentialID.ValueString()
+	case "telnyx":
+		var telnyx PhoneNumberTelnyxModel
+		diags.Append(data.Telnyx.As(ctx, &telnyx, basetypes.ObjectAsOptions{})...)
+		phoneNumber.CredentialID = telnyx.CredentialID.ValueString()
+		phoneNumber.TelnyxAPIKey = telnyx.APIKey.ValueString()
+	case "byo-phone-number":
+		var byo PhoneNumberBYOModel
+		diags.Append(data.BYOPhoneNumber.As(ctx, &byo, basetypes.ObjectAsOptions{})...)
+		phoneNumber.CredentialID = byo.CredentialID.ValueString()
+		if !byo.NumberE164CheckEnabled.IsNull() {
+			enabled := byo.NumberE164CheckEnabled.ValueBool()
+			phoneNumber.NumberE164CheckEnabled = &enabled
+		}
+	case "vapi":
+		var vapi PhoneNumberVapiModel
+		diags.Append(data.Vapi.As(ctx, &vapi, basetypes.ObjectAsOptions{})...)
+		phoneNumber.NumberDesiredAreaCode = vapi.NumberDesiredAreaCode.ValueString()
+		phoneNumber.SipURI = vapi.SipURI.ValueString()
+	}
+
+	return phoneNumber, diags
+}
+
+// phoneNumberFieldValues pairs the API fields of a phone number with the
+// attributes that set them. Provider block attributes are flattened into
+// top-level API fields, some of which the deprecated flat attributes set too.
+func phoneNumberFieldValues(data PhoneNumberResourceModel) []phoneNumberFieldValue {
+	return []phoneNumberFieldValue{
+		{"name", data.Name},
+		{"assistantId", data.AssistantID},
+		{"squadId", data.SquadID},
+		{"workflowId", data.WorkflowID},
+		{"serverUrl", data.ServerURL},
+		{"serverUrlSecret", data.ServerURLSecret},
+		{"twilioAccountSid", data.TwilioAccountSid},
+		{"twilioAccountSid", objectAttribute(data.Twilio, "account_sid")},
+		{"twilioAuthToken", data.TwilioAuthToken},
+		{"twilioAuthToken", objectAttribute(data.Twilio, "auth_token")},
+		{"twilioApiKey", objectAttribute(data.Twilio, "api_key")},
+		{"twilioApiSecret", objectAttribute(data.Twilio, "api_secret")},
+		{"vonageApiKey", data.VonageAPIKey},
+		{"vonageApiSecret", data.VonageAPISecret},
+		{"vonageApplicationId", data.VonageApplicationID},
+		{"credentialId", objectAttribute(data.Vonage, "credential_id")},
+		{"credentialId", objectAttribute(data.Telnyx, "credential_id")},
+		{"credentialId", objectAttribute(data.BYOPhoneNumber, "credential_id")},
+		{"telnyxApiKey", objectAttribute(data.Telnyx, "api_key")},
+		{"numberE164CheckEnabled", objectAttribute(data.BYOPhoneNumber, "number_e164_check_enabled")},
+		{"numberDesiredAreaCode", objectAttribute(data.Vapi, "number_desired_area_code")},
+		{"sipUri", objectAttribute(data.Vapi, "sip_uri")},
+	}
+}
+
+// phoneNumberFieldValue is an API field of a phone number and an attribute that sets it
+type phoneNumberFieldValue struct {
+	field string
+	value attr.Value
+}
+
+// phoneNumberNullFields returns the API fields that are set in the prior state
+// but removed from the plan, so the PATCH request clears them on the server. A
+// field is only cleared when no planned attribute sets it any more, so moving
+// from a deprecated flat attribute to a provider block keeps its value.
+func phoneNumberNullFields(state, plan PhoneNumberResourceModel) []string {
+	planned := make(map[string]bool)
+	for _, value := range phoneNumberFieldValues(plan) {
+		if !value.value.IsNull() {
+			planned[value.field] = true
+		}
+	}
+
+	var nullFields []string
+	for _, value := range phoneNumberFieldValues(state) {
+		if value.value.IsNull() || planned[value.field] {
+			continue
+		}
+		nullFields = append(nullFields, value.field)
+		// Later attributes setting the same field don't clear it again
+		planned[value.field] = true
+	}
+
+	return nullFields
+}
+
+// omitUnchangedPhoneNumberCredentials drops carrier credentials that match the
+// prior state from an update payload, so unchanged secrets aren't sent again
+func omitUnchangedPhoneNumberCredentials(phoneNumber, prior *client.PhoneNumber) {
+	credentials := []struct {
+		value *string
+		prior string
+	}{
+		{&phoneNumber.TwilioAccountSid, prior.TwilioAccountSid},
+		{&phoneNumber.TwilioAuthToken, prior.TwilioAuthToken},
+		{&phoneNumber.TwilioAPIKey, prior.TwilioAPIKey},
+		{&phoneNumber.TwilioAPISecret, prior.TwilioAPISecret},
+		{&phoneNumber.VonageAPIKey, prior.VonageAPIKey},
+		{&phoneNumber.VonageAPISecret, prior.VonageAPISecret},
+		{&phoneNumber.TelnyxAPIKey, prior.TelnyxAPIKey},
+	}
+
+	for _, credential := range credentials {
+		if *credential.value == credential.prior {
+			*credential.value = ""
+		}
+	}
+}
+
+// phoneNumberToModel copies the API response into the Terraform model. The
+// provider block matching the number's provider is refreshed, or populated
+// after an import, when the prior state holds nothing but the ID. Secrets are
+// never returned by the API, so their prior values are kept.
+func phoneNumberToModel(ctx context.Context, phoneNumber *client.PhoneNumber, data *PhoneNumberResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Prior states always hold the number or the provider, only an import leaves both unset
+	importing := data.Number.IsNull() && data.ProviderType.IsNull()
+
+	data.Number = stringValueOrNull(phoneNumber.Number)
+	data.ProviderType = stringValueOrNull(phoneNumber.Provider)
+	data.Name = refreshString(data.Name, phoneNumber.Name, importing)
+	data.AssistantID = refreshString(data.AssistantID, phoneNumber.AssistantID, importing)
+	data.SquadID = refreshString(data.SquadID, phoneNumber.SquadID, importing)
+	data.WorkflowID = refreshString(data.WorkflowID, phoneNumber.WorkflowID, importing)
+	data.ServerURL = refreshString(data.ServerURL, phoneNumber.ServerURL, importing)
+	data.TwilioAccountSid = refreshString(data.TwilioAccountSid, phoneNumber.TwilioAccountSid, false)
+	data.VonageAPIKey = refreshString(data.VonageAPIKey, phoneNumber.VonageAPIKey, false)
+	data.VonageApplicationID = refreshString(data.VonageApplicationID, phoneNumber.VonageApplicationID, false)
+
+	if phoneNumber.Provider != "twilio" || (data.Twilio.IsNull() && !importing) {
+		data.Twilio = types.ObjectNull(phoneNumberTwilioAttrTypes())
+	} else {
+		var prior PhoneNumberTwilioModel
+		if !data.Twilio.IsNull() {
+			diags.Append(data.Twilio.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		twilio := PhoneNumberTwilioModel{
+			AccountSid: stringValueOrPrior(prior.AccountSid, phoneNumber.TwilioAccountSid),
+			AuthToken:  prior.AuthToken,
+			APIKey:     prior.APIKey,
+			APISecret:  prior.APISecret,
+		}
+
+		twilioObject, objectDiags := types.ObjectValueFrom(ctx, phoneNumberTwilioAttrTypes(), twilio)
+		diags.Append(objectDiags...)
+		data.Twilio = twilioObject
+	}
+
+	if phoneNumber.Provider != "vonage" || (data.Vonage.IsNull() && !importing) {
+		data.Vonage = types.ObjectNull(phoneNumberVonageAttrTypes())
+	} else {
+		var prior PhoneNumberVonageModel
+		if !data.Vonage.IsNull() {
+			diags.Append(data.Vonage.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		vonage := PhoneNumberVonageModel{
+			CredentialID: stringValueOrPrior(prior.CredentialID, phoneNumber.CredentialID),
+		}
+
+		vonageObject, objectDiags := types.ObjectValueFrom(ctx, phoneNumberVonageAttrTypes(), vonage)
+		diags.Append(objectDiags...)
+		data.Vonage = vonageObject
+	}
+
+	if phoneNumber.Provider != "telnyx" || (data.Telnyx.IsNull() && !importing) {
+		data.Telnyx = types.ObjectNull(phoneNumberTelnyxAttrTypes())
+	} else {
+		var prior PhoneNumberTelnyxModel
+		if !data.Telnyx.IsNull() {
+			diags.Append(data.Telnyx.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		telnyx := PhoneNumberTelnyxModel{
+			CredentialID: refreshString(prior.CredentialID, phoneNumber.CredentialID, importing),
+			APIKey:       prior.APIKey,
+		}
+
+		telnyxObject, objectDiags := types.ObjectValueFrom(ctx, phoneNumberTelnyxAttrTypes(), telnyx)
+		diags.Append(objectDiags...)
+		data.Telnyx = telnyxObject
+	}
+
+	if phoneNumber.Provider != "byo-phone-number" || (data.BYOPhoneNumber.IsNull() && !importing) {
+		data.BYOPhoneNumber = types.ObjectNull(phoneNumberBYOAttrTypes())
+	} else {
+		var prior PhoneNumberBYOModel
+		if !data.BYOPhoneNumber.IsNull() {
+			diags.Append(data.BYOPhoneNumber.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		byo := PhoneNumberBYOModel{
+			CredentialID:           stringValueOrPrior(prior.CredentialID, phoneNumber.CredentialID),
+			NumberE164CheckEnabled: refreshBool(prior.NumberE164CheckEnabled, phoneNumber.NumberE164CheckEnabled, importing),
+		}
+
+		byoObject, objectDiags := types.ObjectValueFrom(ctx, phoneNumberBYOAttrTypes(), byo)
+		diags.Append(objectDiags...)
+		data.BYOPhoneNumber = byoObject
+	}
+
+	if phoneNumber.Provider != "vapi" || (data.Vapi.IsNull() && !importing) {
+		data.Vapi = types.ObjectNull(phoneNumberVapiAttrTypes())
+	} else {
+		var prior PhoneNumberVapiModel
+		if !data.Vapi.IsNull() {
+			diags.Append(data.Vapi.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		// The desired area code is only an input, the API reports the assigned number instead
+		vapi := PhoneNumberVapiModel{
+			NumberDesiredAreaCode: prior.NumberDesiredAreaCode,
+			SipURI:                refreshString(prior.SipURI, phoneNumber.SipURI, importing),
+		}
+
+		vapiObject, objectDiags := types.ObjectValueFrom(ctx, phoneNumberVapiAttrTypes(), vapi)
+		diags.Append(objectDiags...)
+		data.Vapi = vapiObject
+	}
+
+	data.CredentialID = stringValueOrNull(phoneNumber.CredentialID)
+	data.SipURI = stringValueOrNull(phoneNumber.SipURI)
+	data.Status = stringValueOrNull(phoneNumber.Status)
+	data.OrgID = types.StringValue(phoneNumber.OrgID)
+	data.CreatedAt = types.StringValue(phoneNumber.CreatedAt)
+	data.UpdatedAt = types.StringValue(phoneNumber.UpdatedAt)
+
+	return diags
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testPhoneNumberModel returns a phone number model with only the number set
+func testPhoneNumberModel() PhoneNumberResourceModel {
+	return PhoneNumberResourceModel{
+		Number:              types.StringValue("+14155550100"),
+		Name:                types.StringNull(),
+		AssistantID:         types.StringNull(),
+		SquadID:             types.StringNull(),
+		WorkflowID:          types.StringNull(),
+		ServerURL:           types.StringNull(),
+		ServerURLSecret:     types.StringNull(),
+		ProviderType:        types.StringNull(),
+		Twilio:              types.ObjectNull(phoneNumberTwilioAttrTypes()),
+		Vonage:              types.ObjectNull(phoneNumberVonageAttrTypes()),
+		Telnyx:              types.ObjectNull(phoneNumberTelnyxAttrTypes()),
+		BYOPhoneNumber:      types.ObjectNull(phoneNumberBYOAttrTypes()),
+		Vapi:                types.ObjectNull(phoneNumberVapiAttrTypes()),
+		TwilioAccountSid:    types.StringNull(),
+		TwilioAuthToken:     types.StringNull(),
+		VonageAPIKey:        types.StringNull(),
+		VonageAPISecret:     types.StringNull(),
+		VonageApplicationID: types.StringNull(),
+	}
+}
+
+func TestPhoneNumberNullFields(t *testing.T) {
+	twilio := func(values map[string]attr.Value) types.Object {
+		values["account_sid"] = types.StringValue("AC123")
+		return objectValue(t, phoneNumberTwilioAttrTypes(), values)
+	}
+
+	tests := []struct {
+		name  string
+		state func(*PhoneNumberResourceModel)
+		plan  func(*PhoneNumberResourceModel)
+		want  []string
+	}{
+		{
+			name: "nothing removed",
+			state: func(data *PhoneNumberResourceModel) {
+				data.Name = types.StringValue("Support")
+				data.AssistantID = types.StringValue("assistant-1")
+			},
+			plan: func(data *PhoneNumberResourceModel) {
+				data.Name = types.StringValue("Support line")
+				data.AssistantID = types.StringValue("assistant-2")
+			},
+			want: nil,
+		},
+		{
+			name: "top level fields",
+			state: func(data *PhoneNumberResourceModel) {
+				data.Name = types.StringValue("Support")
+				data.ServerURL = types.StringValue("https://example.com/vapi")
+				data.ServerURLSecret = types.StringValue("secret")
+			},
+			plan: func(data *PhoneNumberResourceModel) {},
+			want: []string{"name", "serverUrl", "serverUrlSecret"},
+		},
+		{
+			name: "route moved to a squad",
+			state: func(data *PhoneNumberResourceModel) {
+				data.AssistantID = types.StringValue("assistant-1")
+			},
+			plan: func(data *PhoneNumberResourceModel) {
+				data.SquadID = types.StringValue("squad-1")
+			},
+			want: []string{"assistantId"},
+		},
+		{
+			name: "provider block fields",
+			state: func(data *PhoneNumberResourceModel) {
+				data.Twilio = twilio(map[string]attr.Value{
+					"api_key":    types.StringValue("SK123"),
+					"api_secret": types.StringValue("secret"),
+				})
+			},
+			plan: func(data *PhoneNumberResourceModel) {
+				data.Twilio = twilio(map[string]attr.Value{})
+			},
+			want: []string{"twilioApiKey", "twilioApiSecret"},
+		},
+		{
+			name: "deprecated attributes moved to the provider block",
+			state: func(data *PhoneNumberResourceModel) {
+				data.TwilioAccountSid = types.StringValue("AC123")
+				data.TwilioAuthToken = types.StringValue("token")
+			},
+			plan: func(data *PhoneNumberResourceModel) {
+				data.Twilio = twilio(map[string]attr.Value{"auth_token": types.StringValue("token")})
+			},
+			want: nil,
+		},
+		{
+			name: "bring your own number options",
+			state: func(data *PhoneNumberResourceModel) {
+				data.BYOPhoneNumber = objectValue(t, phoneNumberBYOAttrTypes(), map[string]attr.Value{
+					"credential_id":             types.StringValue("credential-1"),
+					"number_e164_check_enabled": types.BoolValue(false),
+				})
+			},
+			plan: func(data *PhoneNumberResourceModel) {
+				data.BYOPhoneNumber = objectValue(t, phoneNumberBYOAttrTypes(), map[string]attr.Value{
+					"credential_id": types.StringValue("credential-1"),
+				})
+			},
+			want: []string{"numberE164CheckEnabled"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, plan := testPhoneNumberModel(), testPhoneNumberModel()
+			tt.state(&state)
+			tt.plan(&plan)
+
+			if got := phoneNumberNullFields(state, plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOmitUnchangedPhoneNumberCredentials(t *testing.T) {
+	prior := &client.PhoneNumber{
+		Name:             "Support",
+		TwilioAccountSid: "AC123",
+		TwilioAuthToken:  "token",
+		TwilioAPIKey:     "SK123",
+	}
+	phoneNumber := &client.PhoneNumber{
+		Name:             "Support",
+		TwilioAccountSid: "AC123",
+		TwilioAuthToken:  "rotated",
+		TwilioAPIKey:     "SK123",
+	}
+
+	omitUnchangedPhoneNumberCredentials(phoneNumber, prior)
+
+	want := &client.PhoneNumber{
+		Name:            "Support",
+		TwilioAuthToken: "rotated",
+	}
+	if !reflect.DeepEqual(phoneNumber, want) {
+		t.Errorf("got %+v, want %+v", phoneNumber, want)
+	}
+}
+
+func TestPhoneNumberValidateConfigRoutes(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes map[string]attr.Value
+		want   []string
+	}{
+		{
+			name:   "no route",
+			routes: map[string]attr.Value{},
+			want:   nil,
+		},
+		{
+			name:   "workflow",
+			routes: map[string]attr.Value{"workflow_id": types.StringValue("workflow-1")},
+			want:   nil,
+		},
+		{
+			name: "assistant and workflow",
+			routes: map[string]attr.Value{
+				"assistant_id": types.StringValue("assistant-1"),
+				"workflow_id":  types.StringValue("workflow-1"),
+			},
+			want: []string{"workflow_id"},
+		},
+		{
+			name: "every route",
+			routes: map[string]attr.Value{
+				"assistant_id": types.StringValue("assistant-1"),
+				"squad_id":     types.StringValue("squad-1"),
+				"workflow_id":  types.StringValue("workflow-1"),
+			},
+			want: []string{"squad_id", "workflow_id"},
+		},
+		{
+			name: "route not known until apply",
+			routes: map[string]attr.Value{
+				"squad_id":    types.StringUnknown(),
+				"workflow_id": types.StringValue("workflow-1"),
+			},
+			want: []string{"workflow_id"},
+		},
+	}
+
+	r := NewPhoneNumberResource().(*PhoneNumberResource)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]attr.Value{"number": types.StringValue("+14155550100")}
+			for name, value := range tt.routes {
+				config[name] = value
+			}
+
+			if got := validateConfigErrors(t, r, config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got errors at %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
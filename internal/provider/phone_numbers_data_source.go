@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PhoneNumbersDataSource{}
+
+func NewPhoneNumbersDataSource() datasource.DataSource {
+	return &PhoneNumbersDataSource{}
+}
+
+// PhoneNumbersDataSource defines the data source implementation.
+type PhoneNumbersDataSource struct {
+	client *client.VapiClient
+}
+
+// PhoneNumbersDataSourceModel describes the data source data model.
+type PhoneNumbersDataSourceModel struct {
+	NamePrefix    types.String              `tfsdk:"name_prefix"`
+	NameRegex     types.String              `tfsdk:"name_regex"`
+	ProviderType  types.String              `tfsdk:"provider_type"`
+	AssistantID   types.String              `tfsdk:"assistant_id"`
+	CreatedAfter  types.String              `tfsdk:"created_after"`
+	CreatedBefore types.String              `tfsdk:"created_before"`
+	MaxResults    types.Int64               `tfsdk:"max_results"`
+	IDs           []types.String            `tfsdk:"ids"`
+	PhoneNumbers  []PhoneNumberSummaryModel `tfsdk:"phone_numbers"`
+}
+
+// PhoneNumberSummaryModel describes a phone number returned by the vapi_phone_numbers data source
+type PhoneNumberSummaryModel struct {
+	ID           types.String `tfsdk:"id"`
+	Number       types.String `tfsdk:"number"`
+	Name         types.String `tfsdk:"name"`
+	ProviderType types.String `tfsdk:"provider_type"`
+	AssistantID  types.String `tfsdk:"assistant_id"`
+	SquadID      types.String `tfsdk:"squad_id"`
+	WorkflowID   types.String `tfsdk:"workflow_id"`
+	ServerURL    types.String `tfsdk:"server_url"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+func (d *PhoneNumbersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_phone_numbers"
+}
+
+func (d *PhoneNumbersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the Vapi phone numbers that match every configured filter",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return phone numbers whose name starts with this prefix",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return phone numbers whose name matches this regular expression",
+				Optional:            true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Only return phone numbers from this telephony provider (e.g., twilio, vonage)",
+				Optional:            true,
+			},
+			"assistant_id": schema.StringAttribute{
+				MarkdownDescription: "Only return phone numbers that route calls to this assistant",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only return phone numbers created after this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only return phone numbers created before this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of phone numbers to return, newest first. Defaults to all matching phone numbers",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching phone numbers",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"phone_numbers": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching phone numbers",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Phone number identifier",
+							Computed:            true,
+						},
+						"number": schema.StringAttribute{
+							MarkdownDescription: "Phone number in E.164 format",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Display name for the phone number",
+							Computed:            true,
+						},
+						"provider_type": schema.StringAttribute{
+							MarkdownDescription: "Telephony provider",
+							Computed:            true,
+						},
+						"assistant_id": schema.StringAttribute{
+							MarkdownDescription: "Assistant ID handling calls on this number",
+							Computed:            true,
+						},
+						"squad_id": schema.StringAttribute{
+							MarkdownDescription: "Squad ID handling calls on this number",
+							Computed:            true,
+						},
+						"workflow_id": schema.StringAttribute{
+							MarkdownDescription: "Workflow ID handling calls on this number",
+							Computed:            true,
+						},
+						"server_url": schema.StringAttribute{
+							MarkdownDescription: "Server URL for webhooks",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation timestamp",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last update timestamp",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PhoneNumbersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PhoneNumbersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PhoneNumbersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newListFilter(data.NamePrefix, data.NameRegex, data.CreatedAfter, data.CreatedBefore)
+	resp.Diagnostics.Append(diags...)
+
+	if !data.MaxResults.IsNull() && data.MaxResults.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_results"),
+			"Invalid Max Results",
+			fmt.Sprintf("max_results must be at least 1, got: %d.", data.MaxResults.ValueInt64()),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.IDs = []types.String{}
+	data.PhoneNumbers = []PhoneNumberSummaryModel{}
+
+	// Name, provider and assistant filters are applied locally, so the cap counts matches rather than fetched items
+	it := d.client.IteratePhoneNumbers(filter.listOptions())
+	for it.Next(ctx) {
+		phoneNumber := it.Value()
+		if !filter.matches(phoneNumber.Name, phoneNumber.CreatedAt) {
+			continue
+		}
+
+		if !data.ProviderType.IsNull() && phoneNumber.Provider != data.ProviderType.ValueString() {
+			continue
+		}
+
+		if !data.AssistantID.IsNull() && phoneNumber.AssistantID != data.AssistantID.ValueString() {
+			continue
+		}
+
+		data.IDs = append(data.IDs, types.StringValue(phoneNumber.ID))
+		data.PhoneNumbers = append(data.PhoneNumbers, PhoneNumberSummaryModel{
+			ID:           types.StringValue(phoneNumber.ID),
+			Number:       stringValueOrNull(phoneNumber.Number),
+			Name:         stringValueOrNull(phoneNumber.Name),
+			ProviderType: stringValueOrNull(phoneNumber.Provider),
+			AssistantID:  stringValueOrNull(phoneNumber.AssistantID),
+			SquadID:      stringValueOrNull(phoneNumber.SquadID),
+			WorkflowID:   stringValueOrNull(phoneNumber.WorkflowID),
+			ServerURL:    stringValueOrNull(phoneNumber.ServerURL),
+			CreatedAt:    stringValueOrNull(phoneNumber.CreatedAt),
+			UpdatedAt:    stringValueOrNull(phoneNumber.UpdatedAt),
+		})
+
+		if !data.MaxResults.IsNull() && int64(len(data.PhoneNumbers)) >= data.MaxResults.ValueInt64() {
+			break
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list phone numbers, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
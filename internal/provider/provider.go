@@ -2,17 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"terraform-provider-vapi/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultRequestTimeout bounds a single API request attempt unless request_timeout is set
+const defaultRequestTimeout = 30 * time.Second
+
 // Ensure VapiProvider satisfies various provider interfaces.
 var _ provider.Provider = &VapiProvider{}
 
@@ -26,8 +32,11 @@ type VapiProvider struct {
 
 // VapiProviderModel describes the provider data model.
 type VapiProviderModel struct {
-	URL    types.String `tfsdk:"url"`
-	ApiKey types.String `tfsdk:"api_key"`
+	URL            types.String `tfsdk:"url"`
+	ApiKey         types.String `tfsdk:"api_key"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait   types.String `tfsdk:"retry_max_wait"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
 }
 
 func (p *VapiProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,6 +56,18 @@ func (p *VapiProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for rate limited or transiently failing API requests. Defaults to 4, set to 0 to disable retries",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum wait between retries as a duration (e.g., `30s`, `1m`). Defaults to `30s`. Longer waits Vapi asks for with `Retry-After` are capped at this value",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for a single API request attempt as a duration (e.g., `30s`, `2m`). Defaults to `30s`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -83,8 +104,56 @@ func (p *VapiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	retryConfig := client.DefaultRetryConfig
+
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"max_retries cannot be negative.",
+			)
+			return
+		}
+		retryConfig.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if !data.RetryMaxWait.IsNull() {
+		maxWait, err := time.ParseDuration(data.RetryMaxWait.ValueString())
+		if err != nil || maxWait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				fmt.Sprintf("retry_max_wait must be a positive duration such as \"30s\", got: %q.", data.RetryMaxWait.ValueString()),
+			)
+			return
+		}
+		retryConfig.MaxWait = maxWait
+		if retryConfig.MinWait > maxWait {
+			retryConfig.MinWait = maxWait
+		}
+	}
+
+	requestTimeout := defaultRequestTimeout
+
+	if !data.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"30s\", got: %q.", data.RequestTimeout.ValueString()),
+			)
+			return
+		}
+		requestTimeout = timeout
+	}
+
 	// Example client configuration for data sources and resources
-	client := client.NewVapiClient(url, apiKey)
+	client := client.NewVapiClient(url, apiKey,
+		client.WithRetryConfig(retryConfig),
+		client.WithRequestTimeout(requestTimeout),
+	)
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
@@ -92,13 +161,22 @@ func (p *VapiProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 func (p *VapiProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAssistantResource,
+		NewCredentialResource,
+		NewFileResource,
+		NewKnowledgeBaseResource,
 		NewPhoneNumberResource,
+		NewSipTrunkResource,
+		NewSquadResource,
+		NewToolResource,
+		NewWorkflowResource,
 	}
 }
 
 func (p *VapiProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// Add data sources here when needed
+		NewAssistantDataSource,
+		NewAssistantsDataSource,
+		NewPhoneNumbersDataSource,
 	}
 }
 
@@ -0,0 +1,535 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SipTrunkResource{}
+var _ resource.ResourceWithImportState = &SipTrunkResource{}
+
+// sipTrunkFieldRenames maps Vapi field paths to differently named SIP trunk attributes
+var sipTrunkFieldRenames = map[string]string{
+	"outboundAuthenticationPlan.authUsername": "outbound_authentication.username",
+	"outboundAuthenticationPlan.authPassword": "outbound_authentication.password",
+	"outboundAuthenticationPlan":              "outbound_authentication",
+}
+
+// sipTrunkOutboundProtocols lists the transports Vapi can use for outbound calls to a gateway
+var sipTrunkOutboundProtocols = []string{"tls/srtp", "tcp", "tls", "udp"}
+
+func NewSipTrunkResource() resource.Resource {
+	return &SipTrunkResource{}
+}
+
+// SipTrunkResource defines the resource implementation.
+type SipTrunkResource struct {
+	client *client.VapiClient
+}
+
+// SipTrunkResourceModel describes the resource data model.
+type SipTrunkResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	Gateways                   types.List   `tfsdk:"gateways"`
+	OutboundAuthentication     types.Object `tfsdk:"outbound_authentication"`
+	OutboundLeadingPlusEnabled types.Bool   `tfsdk:"outbound_leading_plus_enabled"`
+	TechPrefix                 types.String `tfsdk:"tech_prefix"`
+	SipDiversionHeader         types.String `tfsdk:"sip_diversion_header"`
+	OrgID                      types.String `tfsdk:"org_id"`
+	CreatedAt                  types.String `tfsdk:"created_at"`
+	UpdatedAt                  types.String `tfsdk:"updated_at"`
+}
+
+// SipTrunkGatewayModel describes a SIP gateway of the trunk
+type SipTrunkGatewayModel struct {
+	IP                 types.String `tfsdk:"ip"`
+	Port               types.Int64  `tfsdk:"port"`
+	Netmask            types.Int64  `tfsdk:"netmask"`
+	InboundEnabled     types.Bool   `tfsdk:"inbound_enabled"`
+	OutboundEnabled    types.Bool   `tfsdk:"outbound_enabled"`
+	OutboundProtocol   types.String `tfsdk:"outbound_protocol"`
+	OptionsPingEnabled types.Bool   `tfsdk:"options_ping_enabled"`
+}
+
+// SipTrunkOutboundAuthenticationModel describes the credentials sent on outbound calls
+type SipTrunkOutboundAuthenticationModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func sipTrunkGatewayAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ip":                   types.StringType,
+		"port":                 types.Int64Type,
+		"netmask":              types.Int64Type,
+		"inbound_enabled":      types.BoolType,
+		"outbound_enabled":     types.BoolType,
+		"outbound_protocol":    types.StringType,
+		"options_ping_enabled": types.BoolType,
+	}
+}
+
+func sipTrunkOutboundAuthenticationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"username": types.StringType,
+		"password": types.StringType,
+	}
+}
+
+func (r *SipTrunkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sip_trunk"
+}
+
+func (r *SipTrunkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vapi bring-your-own SIP trunk resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SIP trunk credential identifier, used as byo_phone_number.credential_id on vapi_phone_number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name for the SIP trunk",
+				Optional:            true,
+			},
+			"gateways": schema.ListNestedAttribute{
+				MarkdownDescription: "SIP gateways calls are exchanged with",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "IPv4 address or fully qualified domain name of the gateway",
+							Required:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "SIP port of the gateway. Defaults to 5060",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"netmask": schema.Int64Attribute{
+							MarkdownDescription: "Netmask of the gateway address, for accepting inbound calls from a range. Defaults to 32",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(24, 32),
+							},
+						},
+						"inbound_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether calls from the gateway are accepted. Defaults to true",
+							Optional:            true,
+						},
+						"outbound_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether outbound calls are sent to the gateway. Defaults to true",
+							Optional:            true,
+						},
+						"outbound_protocol": schema.StringAttribute{
+							MarkdownDescription: "Transport for outbound calls (udp, tcp, tls, tls/srtp). Defaults to udp",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(sipTrunkOutboundProtocols...),
+							},
+						},
+						"options_ping_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether Vapi sends SIP OPTIONS pings to check the gateway is up",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"outbound_authentication": schema.SingleNestedAttribute{
+				MarkdownDescription: "Digest credentials Vapi sends when the gateway challenges an outbound call",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						MarkdownDescription: "Authentication username",
+						Required:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Authentication password",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"outbound_leading_plus_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether outbound numbers are dialed with a leading + (E.164). Disable for carriers that expect national format",
+				Optional:            true,
+			},
+			"tech_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix added to the dialed number on outbound calls, for carriers that route on a tech prefix",
+				Optional:            true,
+			},
+			"sip_diversion_header": schema.StringAttribute{
+				MarkdownDescription: "Value of the SIP Diversion header sent on outbound calls, for carriers that require it to authorize the caller ID",
+				Optional:            true,
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization the SIP trunk belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SipTrunkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SipTrunkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SipTrunkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	sipTrunk, diags := sipTrunkFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sipTrunk.Provider = client.SipTrunkProvider
+
+	// Create the SIP trunk
+	createdSipTrunk, err := r.client.CreateSipTrunk(ctx, sipTrunk)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create SIP trunk", err, sipTrunkFieldRenames)
+		return
+	}
+
+	// Update the model with the created SIP trunk data
+	data.ID = types.StringValue(createdSipTrunk.ID)
+	data.OrgID = types.StringValue(createdSipTrunk.OrgID)
+	data.CreatedAt = types.StringValue(createdSipTrunk.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdSipTrunk.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SipTrunkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SipTrunkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the SIP trunk from the API
+	sipTrunk, err := r.client.GetSipTrunk(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SIP trunk, got error: %s", err))
+		return
+	}
+
+	// Update the model with the SIP trunk data
+	resp.Diagnostics.Append(sipTrunkToModel(ctx, sipTrunk, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SipTrunkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SipTrunkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SipTrunkResourceModel
+
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	sipTrunk, diags := sipTrunkFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sipTrunk.NullFields = sipTrunkNullFields(state, data)
+
+	// Update the SIP trunk
+	updatedSipTrunk, err := r.client.UpdateSipTrunk(ctx, data.ID.ValueString(), sipTrunk)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update SIP trunk", err, sipTrunkFieldRenames)
+		return
+	}
+
+	// Update the model with the updated SIP trunk data
+	data.OrgID = types.StringValue(updatedSipTrunk.OrgID)
+	data.CreatedAt = types.StringValue(updatedSipTrunk.CreatedAt)
+	data.UpdatedAt = types.StringValue(updatedSipTrunk.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SipTrunkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SipTrunkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the SIP trunk, treating one that is already gone as deleted
+	err := r.client.DeleteSipTrunk(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SIP trunk, got error: %s", err))
+		return
+	}
+}
+
+func (r *SipTrunkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// sipTrunkFromModel converts the Terraform model into the API payload
+func sipTrunkFromModel(ctx context.Context, data SipTrunkResourceModel) (*client.SipTrunk, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sipTrunk := &client.SipTrunk{
+		Name:               data.Name.ValueString(),
+		TechPrefix:         data.TechPrefix.ValueString(),
+		SipDiversionHeader: data.SipDiversionHeader.ValueString(),
+	}
+
+	if !data.OutboundLeadingPlusEnabled.IsNull() {
+		enabled := data.OutboundLeadingPlusEnabled.ValueBool()
+		sipTrunk.OutboundLeadingPlusEnabled = &enabled
+	}
+
+	var gateways []SipTrunkGatewayModel
+	diags.Append(data.Gateways.ElementsAs(ctx, &gateways, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, gatewayData := range gateways {
+		gateway := client.SipTrunkGateway{
+			IP:               gatewayData.IP.ValueString(),
+			OutboundProtocol: gatewayData.OutboundProtocol.ValueString(),
+		}
+
+		if !gatewayData.Port.IsNull() {
+			port := int(gatewayData.Port.ValueInt64())
+			gateway.Port = &port
+		}
+
+		if !gatewayData.Netmask.IsNull() {
+			netmask := int(gatewayData.Netmask.ValueInt64())
+			gateway.Netmask = &netmask
+		}
+
+		if !gatewayData.InboundEnabled.IsNull() {
+			enabled := gatewayData.InboundEnabled.ValueBool()
+			gateway.InboundEnabled = &enabled
+		}
+
+		if !gatewayData.OutboundEnabled.IsNull() {
+			enabled := gatewayData.OutboundEnabled.ValueBool()
+			gateway.OutboundEnabled = &enabled
+		}
+
+		if !gatewayData.OptionsPingEnabled.IsNull() {
+			enabled := gatewayData.OptionsPingEnabled.ValueBool()
+			gateway.OptionsPingEnabled = &enabled
+		}
+
+		sipTrunk.Gateways = append(sipTrunk.Gateways, gateway)
+	}
+
+	if !data.OutboundAuthentication.IsNull() {
+		var authentication SipTrunkOutboundAuthenticationModel
+		diags.Append(data.OutboundAuthentication.As(ctx, &authentication, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		sipTrunk.OutboundAuthenticationPlan = &client.SipTrunkAuthenticationPlan{
+			AuthUsername: authentication.Username.ValueString(),
+			AuthPassword: authentication.Password.ValueString(),
+		}
+	}
+
+	return sipTrunk, diags
+}
+
+// sipTrunkNullFields returns the API fields that are set in the prior state but
+// removed from the plan, so the PATCH request clears them on the server.
+func sipTrunkNullFields(state, plan SipTrunkResourceModel) []string {
+	candidates := []struct {
+		field   string
+		prior   attr.Value
+		planned attr.Value
+	}{
+		{"outboundAuthenticationPlan", state.OutboundAuthentication, plan.OutboundAuthentication},
+		{"outboundLeadingPlusEnabled", state.OutboundLeadingPlusEnabled, plan.OutboundLeadingPlusEnabled},
+		{"techPrefix", state.TechPrefix, plan.TechPrefix},
+		{"sipDiversionHeader", state.SipDiversionHeader, plan.SipDiversionHeader},
+	}
+
+	var nullFields []string
+	for _, candidate := range candidates {
+		if !candidate.prior.IsNull() && candidate.planned.IsNull() {
+			nullFields = append(nullFields, candidate.field)
+		}
+	}
+
+	return nullFields
+}
+
+// sipTrunkToModel copies the API response into the Terraform model. Optional
+// attributes missing from the prior state are only populated after an import,
+// when the prior state holds nothing but the ID. The outbound password is never
+// returned by the API, so its prior value is kept.
+func sipTrunkToModel(ctx context.Context, sipTrunk *client.SipTrunk, data *SipTrunkResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	importing := data.Gateways.IsNull()
+
+	var priorGateways []SipTrunkGatewayModel
+	if !data.Gateways.IsNull() && !data.Gateways.IsUnknown() {
+		diags.Append(data.Gateways.ElementsAs(ctx, &priorGateways, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	gateways := make([]SipTrunkGatewayModel, 0, len(sipTrunk.Gateways))
+	for i, gateway := range sipTrunk.Gateways {
+		// Gateways added outside Terraform have no prior element, so their optional attributes stay null
+		var prior SipTrunkGatewayModel
+		if i < len(priorGateways) {
+			prior = priorGateways[i]
+		}
+
+		gateways = append(gateways, SipTrunkGatewayModel{
+			IP:                 types.StringValue(gateway.IP),
+			Port:               refreshInt64(prior.Port, gateway.Port, importing),
+			Netmask:            refreshInt64(prior.Netmask, gateway.Netmask, importing),
+			InboundEnabled:     refreshBool(prior.InboundEnabled, gateway.InboundEnabled, importing),
+			OutboundEnabled:    refreshBool(prior.OutboundEnabled, gateway.OutboundEnabled, importing),
+			OutboundProtocol:   refreshString(prior.OutboundProtocol, gateway.OutboundProtocol, importing),
+			OptionsPingEnabled: refreshBool(prior.OptionsPingEnabled, gateway.OptionsPingEnabled, importing),
+		})
+	}
+
+	gatewayList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: sipTrunkGatewayAttrTypes()}, gateways)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sipTrunk.OutboundAuthenticationPlan == nil {
+		data.OutboundAuthentication = types.ObjectNull(sipTrunkOutboundAuthenticationAttrTypes())
+	} else {
+		var prior SipTrunkOutboundAuthenticationModel
+		if !data.OutboundAuthentication.IsNull() {
+			diags.Append(data.OutboundAuthentication.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		authenticationObject, objectDiags := types.ObjectValueFrom(ctx, sipTrunkOutboundAuthenticationAttrTypes(), SipTrunkOutboundAuthenticationModel{
+			Username: stringValueOrPrior(prior.Username, sipTrunk.OutboundAuthenticationPlan.AuthUsername),
+			Password: prior.Password,
+		})
+		diags.Append(objectDiags...)
+		data.OutboundAuthentication = authenticationObject
+	}
+
+	data.Name = refreshString(data.Name, sipTrunk.Name, importing)
+	data.Gateways = gatewayList
+	data.OutboundLeadingPlusEnabled = refreshBool(data.OutboundLeadingPlusEnabled, sipTrunk.OutboundLeadingPlusEnabled, importing)
+	data.TechPrefix = refreshString(data.TechPrefix, sipTrunk.TechPrefix, importing)
+	data.SipDiversionHeader = refreshString(data.SipDiversionHeader, sipTrunk.SipDiversionHeader, importing)
+	data.OrgID = types.StringValue(sipTrunk.OrgID)
+	data.CreatedAt = types.StringValue(sipTrunk.CreatedAt)
+	data.UpdatedAt = types.StringValue(sipTrunk.UpdatedAt)
+
+	return diags
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// testSipTrunkGateway returns a gateway model with only the IP set
+func testSipTrunkGateway(ip string) SipTrunkGatewayModel {
+	return SipTrunkGatewayModel{
+		IP:                 types.StringValue(ip),
+		Port:               types.Int64Null(),
+		Netmask:            types.Int64Null(),
+		InboundEnabled:     types.BoolNull(),
+		OutboundEnabled:    types.BoolNull(),
+		OutboundProtocol:   types.StringNull(),
+		OptionsPingEnabled: types.BoolNull(),
+	}
+}
+
+// testSipTrunkModel returns a SIP trunk model with the given gateways and
+// every optional attribute null
+func testSipTrunkModel(t *testing.T, gateways ...SipTrunkGatewayModel) SipTrunkResourceModel {
+	t.Helper()
+
+	gatewayList, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: sipTrunkGatewayAttrTypes()}, gateways)
+	if diags.HasError() {
+		t.Fatalf("gateway diagnostics: %v", diags)
+	}
+
+	return SipTrunkResourceModel{
+		ID:                         types.StringValue("sip-trunk-1"),
+		Name:                       types.StringNull(),
+		Gateways:                   gatewayList,
+		OutboundAuthentication:     types.ObjectNull(sipTrunkOutboundAuthenticationAttrTypes()),
+		OutboundLeadingPlusEnabled: types.BoolNull(),
+		TechPrefix:                 types.StringNull(),
+		SipDiversionHeader:         types.StringNull(),
+	}
+}
+
+func TestSipTrunkFromModel(t *testing.T) {
+	port := 5061
+	netmask := 32
+	enabled := true
+	disabled := false
+
+	tests := []struct {
+		name string
+		data func(*testing.T) SipTrunkResourceModel
+		want *client.SipTrunk
+	}{
+		{
+			name: "gateway only",
+			data: func(t *testing.T) SipTrunkResourceModel {
+				return testSipTrunkModel(t, testSipTrunkGateway("203.0.113.10"))
+			},
+			want: &client.SipTrunk{
+				Gateways: []client.SipTrunkGateway{{IP: "203.0.113.10"}},
+			},
+		},
+		{
+			name: "every attribute",
+			data: func(t *testing.T) SipTrunkResourceModel {
+				gateway := testSipTrunkGateway("203.0.113.10")
+				gateway.Port = types.Int64Value(5061)
+				gateway.Netmask = types.Int64Value(32)
+				gateway.InboundEnabled = types.BoolValue(false)
+				gateway.OutboundEnabled = types.BoolValue(true)
+				gateway.OutboundProtocol = types.StringValue("tls")
+				gateway.OptionsPingEnabled = types.BoolValue(true)
+
+				data := testSipTrunkModel(t, gateway, testSipTrunkGateway("203.0.113.11"))
+				data.Name = types.StringValue("Carrier")
+				data.OutboundLeadingPlusEnabled = types.BoolValue(true)
+				data.TechPrefix = types.StringValue("1234")
+				data.SipDiversionHeader = types.StringValue("sip:+14155550100@carrier.example.com")
+				data.OutboundAuthentication = objectValue(t, sipTrunkOutboundAuthenticationAttrTypes(), map[string]attr.Value{
+					"username": types.StringValue("vapi"),
+					"password": types.StringValue("secret"),
+				})
+				return data
+			},
+			want: &client.SipTrunk{
+				Name: "Carrier",
+				Gateways: []client.SipTrunkGateway{
+					{
+						IP:                 "203.0.113.10",
+						Port:               &port,
+						Netmask:            &netmask,
+						InboundEnabled:     &disabled,
+						OutboundEnabled:    &enabled,
+						OutboundProtocol:   "tls",
+						OptionsPingEnabled: &enabled,
+					},
+					{IP: "203.0.113.11"},
+				},
+				OutboundAuthenticationPlan: &client.SipTrunkAuthenticationPlan{
+					AuthUsername: "vapi",
+					AuthPassword: "secret",
+				},
+				OutboundLeadingPlusEnabled: &enabled,
+				TechPrefix:                 "1234",
+				SipDiversionHeader:         "sip:+14155550100@carrier.example.com",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := sipTrunkFromModel(context.Background(), tt.data(t))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSipTrunkToModel(t *testing.T) {
+	port := 5060
+	enabled := true
+
+	remote := &client.SipTrunk{
+		ID:   "sip-trunk-1",
+		Name: "Carrier",
+		Gateways: []client.SipTrunkGateway{
+			{IP: "203.0.113.10", Port: &port, InboundEnabled: &enabled},
+			{IP: "203.0.113.11", Port: &port},
+		},
+		OutboundAuthenticationPlan: &client.SipTrunkAuthenticationPlan{AuthUsername: "vapi"},
+		OutboundLeadingPlusEnabled: &enabled,
+		OrgID:                      "org-1",
+		CreatedAt:                  "2024-01-01T00:00:00Z",
+		UpdatedAt:                  "2024-01-02T00:00:00Z",
+	}
+
+	tests := []struct {
+		name                   string
+		prior                  func(*testing.T) SipTrunkResourceModel
+		wantName               types.String
+		wantGateways           []SipTrunkGatewayModel
+		wantAuthentication     SipTrunkOutboundAuthenticationModel
+		wantLeadingPlusEnabled types.Bool
+	}{
+		{
+			name: "refresh keeps server defaults out of the state",
+			prior: func(t *testing.T) SipTrunkResourceModel {
+				gateway := testSipTrunkGateway("203.0.113.10")
+				gateway.InboundEnabled = types.BoolValue(true)
+
+				data := testSipTrunkModel(t, gateway)
+				data.OutboundAuthentication = objectValue(t, sipTrunkOutboundAuthenticationAttrTypes(), map[string]attr.Value{
+					"username": types.StringValue("vapi"),
+					"password": types.StringValue("secret"),
+				})
+				return data
+			},
+			wantName: types.StringNull(),
+			wantGateways: []SipTrunkGatewayModel{
+				func() SipTrunkGatewayModel {
+					gateway := testSipTrunkGateway("203.0.113.10")
+					gateway.InboundEnabled = types.BoolValue(true)
+					return gateway
+				}(),
+				// Gateways added outside Terraform have no prior values to refresh
+				testSipTrunkGateway("203.0.113.11"),
+			},
+			wantAuthentication: SipTrunkOutboundAuthenticationModel{
+				Username: types.StringValue("vapi"),
+				Password: types.StringValue("secret"),
+			},
+			wantLeadingPlusEnabled: types.BoolNull(),
+		},
+		{
+			name: "import populates every attribute",
+			prior: func(t *testing.T) SipTrunkResourceModel {
+				data := testSipTrunkModel(t)
+				data.Gateways = types.ListNull(types.ObjectType{AttrTypes: sipTrunkGatewayAttrTypes()})
+				return data
+			},
+			wantName: types.StringValue("Carrier"),
+			wantGateways: []SipTrunkGatewayModel{
+				func() SipTrunkGatewayModel {
+					gateway := testSipTrunkGateway("203.0.113.10")
+					gateway.Port = types.Int64Value(5060)
+					gateway.InboundEnabled = types.BoolValue(true)
+					return gateway
+				}(),
+				func() SipTrunkGatewayModel {
+					gateway := testSipTrunkGateway("203.0.113.11")
+					gateway.Port = types.Int64Value(5060)
+					return gateway
+				}(),
+			},
+			// The password is never returned by the API
+			wantAuthentication: SipTrunkOutboundAuthenticationModel{
+				Username: types.StringValue("vapi"),
+				Password: types.StringNull(),
+			},
+			wantLeadingPlusEnabled: types.BoolValue(true),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			data := tt.prior(t)
+
+			if diags := sipTrunkToModel(ctx, remote, &data); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !data.Name.Equal(tt.wantName) {
+				t.Errorf("got name %s, want %s", data.Name, tt.wantName)
+			}
+			if !data.OutboundLeadingPlusEnabled.Equal(tt.wantLeadingPlusEnabled) {
+				t.Errorf("got outbound_leading_plus_enabled %s, want %s", data.OutboundLeadingPlusEnabled, tt.wantLeadingPlusEnabled)
+			}
+			if !data.OrgID.Equal(types.StringValue("org-1")) || !data.UpdatedAt.Equal(types.StringValue("2024-01-02T00:00:00Z")) {
+				t.Errorf("got org_id %s and updated_at %s from the API response", data.OrgID, data.UpdatedAt)
+			}
+
+			var gateways []SipTrunkGatewayModel
+			if diags := data.Gateways.ElementsAs(ctx, &gateways, false); diags.HasError() {
+				t.Fatalf("gateway diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(gateways, tt.wantGateways) {
+				t.Errorf("got gateways %+v, want %+v", gateways, tt.wantGateways)
+			}
+
+			var authentication SipTrunkOutboundAuthenticationModel
+			if diags := data.OutboundAuthentication.As(ctx, &authentication, basetypes.ObjectAsOptions{}); diags.HasError() {
+				t.Fatalf("outbound_authentication diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(authentication, tt.wantAuthentication) {
+				t.Errorf("got outbound_authentication %+v, want %+v", authentication, tt.wantAuthentication)
+			}
+		})
+	}
+}
+
+func TestSipTrunkNullFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		state func(*SipTrunkResourceModel)
+		plan  func(*SipTrunkResourceModel)
+		want  []string
+	}{
+		{
+			name: "nothing removed",
+			state: func(data *SipTrunkResourceModel) {
+				data.TechPrefix = types.StringValue("1234")
+			},
+			plan: func(data *SipTrunkResourceModel) {
+				data.TechPrefix = types.StringValue("5678")
+			},
+			want: nil,
+		},
+		{
+			name: "removed attributes",
+			state: func(data *SipTrunkResourceModel) {
+				data.Name = types.StringValue("Carrier")
+				data.OutboundLeadingPlusEnabled = types.BoolValue(true)
+				data.TechPrefix = types.StringValue("1234")
+				data.SipDiversionHeader = types.StringValue("sip:+14155550100@carrier.example.com")
+			},
+			plan: func(data *SipTrunkResourceModel) {
+				data.Name = types.StringValue("Carrier")
+			},
+			want: []string{"outboundLeadingPlusEnabled", "techPrefix", "sipDiversionHeader"},
+		},
+		{
+			name: "outbound authentication",
+			state: func(data *SipTrunkResourceModel) {
+				data.OutboundAuthentication = objectValue(t, sipTrunkOutboundAuthenticationAttrTypes(), map[string]attr.Value{
+					"username": types.StringValue("vapi"),
+				})
+			},
+			plan: func(data *SipTrunkResourceModel) {},
+			want: []string{"outboundAuthenticationPlan"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, plan := testSipTrunkModel(t, testSipTrunkGateway("203.0.113.10")), testSipTrunkModel(t, testSipTrunkGateway("203.0.113.10"))
+			tt.state(&state)
+			tt.plan(&plan)
+
+			if got := sipTrunkNullFields(state, plan); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
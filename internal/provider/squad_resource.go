@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SquadResource{}
+var _ resource.ResourceWithImportState = &SquadResource{}
+
+func NewSquadResource() resource.Resource {
+	return &SquadResource{}
+}
+
+// SquadResource defines the resource implementation.
+type SquadResource struct {
+	client *client.VapiClient
+}
+
+// SquadResourceModel describes the resource data model.
+type SquadResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Members   types.List   `tfsdk:"members"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// SquadMemberModel describes a squad member
+type SquadMemberModel struct {
+	AssistantID           types.String `tfsdk:"assistant_id"`
+	AssistantOverrides    types.String `tfsdk:"assistant_overrides"`
+	AssistantDestinations types.List   `tfsdk:"assistant_destinations"`
+}
+
+// SquadMemberDestinationModel describes a transfer destination of a squad member
+type SquadMemberDestinationModel struct {
+	AssistantName types.String `tfsdk:"assistant_name"`
+	Message       types.String `tfsdk:"message"`
+	Description   types.String `tfsdk:"description"`
+	TransferMode  types.String `tfsdk:"transfer_mode"`
+}
+
+func squadMemberDestinationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"assistant_name": types.StringType,
+		"message":        types.StringType,
+		"description":    types.StringType,
+		"transfer_mode":  types.StringType,
+	}
+}
+
+func squadMemberAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"assistant_id":        types.StringType,
+		"assistant_overrides": types.StringType,
+		"assistant_destinations": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: squadMemberDestinationAttrTypes()},
+		},
+	}
+}
+
+func (r *SquadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_squad"
+}
+
+func (r *SquadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vapi Squad resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Squad identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Squad name",
+				Optional:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "Assistants in the squad. The first member starts the call",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"assistant_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the assistant for this member",
+							Required:            true,
+						},
+						"assistant_overrides": schema.StringAttribute{
+							MarkdownDescription: "JSON-encoded assistant settings that override the assistant's own configuration within this squad",
+							Optional:            true,
+						},
+						"assistant_destinations": schema.ListNestedAttribute{
+							MarkdownDescription: "Other squad members this member can transfer the call to",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"assistant_name": schema.StringAttribute{
+										MarkdownDescription: "Name of the assistant to transfer to",
+										Required:            true,
+									},
+									"message": schema.StringAttribute{
+										MarkdownDescription: "Message spoken to the caller before the transfer",
+										Optional:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "Description used by the model to decide when to transfer",
+										Optional:            true,
+									},
+									"transfer_mode": schema.StringAttribute{
+										MarkdownDescription: "How the conversation history is handed over (e.g., rolling-history, swap-system-message-in-history)",
+										Optional:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SquadResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SquadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SquadResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	squad, diags := squadFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the squad
+	createdSquad, err := r.client.CreateSquad(ctx, squad)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create squad", err, nil)
+		return
+	}
+
+	// Update the model with the created squad data
+	data.ID = types.StringValue(createdSquad.ID)
+	data.CreatedAt = types.StringValue(createdSquad.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdSquad.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SquadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SquadResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the squad from the API
+	squad, err := r.client.GetSquad(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read squad", err, nil)
+		return
+	}
+
+	// Update the model with the squad data
+	resp.Diagnostics.Append(squadToModel(ctx, squad, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SquadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SquadResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SquadResourceModel
+
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	squad, diags := squadFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Name.IsNull() && data.Name.IsNull() {
+		squad.NullFields = append(squad.NullFields, "name")
+	}
+
+	// Update the squad
+	updatedSquad, err := r.client.UpdateSquad(ctx, data.ID.ValueString(), squad)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update squad", err, nil)
+		return
+	}
+
+	// Update the model with the updated squad data
+	data.UpdatedAt = types.StringValue(updatedSquad.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SquadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SquadResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the squad, treating one that is already gone as deleted
+	err := r.client.DeleteSquad(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete squad", err, nil)
+		return
+	}
+}
+
+func (r *SquadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// squadFromModel converts the Terraform model into the API payload
+func squadFromModel(ctx context.Context, data SquadResourceModel) (*client.Squad, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	squad := &client.Squad{
+		Name:    data.Name.ValueString(),
+		Members: []client.SquadMember{},
+	}
+
+	var members []SquadMemberModel
+	diags.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, memberData := range members {
+		member := client.SquadMember{
+			AssistantID: memberData.AssistantID.ValueString(),
+		}
+
+		overrides, err := parseJSONObject(memberData.AssistantOverrides)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("members").AtListIndex(i).AtName("assistant_overrides"),
+				"Invalid Assistant Overrides",
+				err.Error(),
+			)
+			return nil, diags
+		}
+		member.AssistantOverrides = overrides
+
+		if !memberData.AssistantDestinations.IsNull() {
+			var destinations []SquadMemberDestinationModel
+			diags.Append(memberData.AssistantDestinations.ElementsAs(ctx, &destinations, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			for _, destinationData := range destinations {
+				member.AssistantDestinations = append(member.AssistantDestinations, client.SquadMemberDestination{
+					Type:          "assistant",
+					AssistantName: destinationData.AssistantName.ValueString(),
+					Message:       destinationData.Message.ValueString(),
+					Description:   destinationData.Description.ValueString(),
+					TransferMode:  destinationData.TransferMode.ValueString(),
+				})
+			}
+		}
+
+		squad.Members = append(squad.Members, member)
+	}
+
+	return squad, diags
+}
+
+// squadToModel copies the API response into the Terraform model
+func squadToModel(ctx context.Context, squad *client.Squad, data *SquadResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var priorMembers []SquadMemberModel
+	if !data.Members.IsNull() && !data.Members.IsUnknown() {
+		diags.Append(data.Members.ElementsAs(ctx, &priorMembers, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	members := make([]SquadMemberModel, 0, len(squad.Members))
+	for i, member := range squad.Members {
+		priorOverrides := types.StringNull()
+		if i < len(priorMembers) {
+			priorOverrides = priorMembers[i].AssistantOverrides
+		}
+
+		overrides, err := jsonStringValue(priorOverrides, member.AssistantOverrides)
+		if err != nil {
+			diags.AddError("Invalid Assistant Overrides", err.Error())
+			return diags
+		}
+
+		destinationType := types.ObjectType{AttrTypes: squadMemberDestinationAttrTypes()}
+		destinationList := types.ListNull(destinationType)
+		if len(member.AssistantDestinations) > 0 {
+			destinations := make([]SquadMemberDestinationModel, 0, len(member.AssistantDestinations))
+			for _, destination := range member.AssistantDestinations {
+				destinations = append(destinations, SquadMemberDestinationModel{
+					AssistantName: types.StringValue(destination.AssistantName),
+					Message:       stringValueOrNull(destination.Message),
+					Description:   stringValueOrNull(destination.Description),
+					TransferMode:  stringValueOrNull(destination.TransferMode),
+				})
+			}
+
+			var listDiags diag.Diagnostics
+			destinationList, listDiags = types.ListValueFrom(ctx, destinationType, destinations)
+			diags.Append(listDiags...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		members = append(members, SquadMemberModel{
+			AssistantID:           types.StringValue(member.AssistantID),
+			AssistantOverrides:    overrides,
+			AssistantDestinations: destinationList,
+		})
+	}
+
+	memberList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: squadMemberAttrTypes()}, members)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Name = stringValueOrNull(squad.Name)
+	data.Members = memberList
+	data.CreatedAt = types.StringValue(squad.CreatedAt)
+	data.UpdatedAt = types.StringValue(squad.UpdatedAt)
+
+	return diags
+}
@@ -0,0 +1,11 @@
+package provider
+
+import "time"
+
+// Default operation timeouts, used when a resource has no timeouts block
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
@@ -0,0 +1,966 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ToolResource{}
+var _ resource.ResourceWithImportState = &ToolResource{}
+var _ resource.ResourceWithValidateConfig = &ToolResource{}
+
+// toolTypes lists the tool kinds supported by the vapi_tool resource
+var toolTypes = []string{"function", "transferCall", "endCall", "dtmf", "query"}
+
+// toolFieldRenames maps Vapi field paths to differently named tool attributes
+var toolFieldRenames = map[string]string{
+	"destinations":   "transfer_call.destinations",
+	"knowledgeBases": "query.knowledge_bases",
+}
+
+func NewToolResource() resource.Resource {
+	return &ToolResource{}
+}
+
+// ToolResource defines the resource implementation.
+type ToolResource struct {
+	client *client.VapiClient
+}
+
+// ToolResourceModel describes the resource data model.
+type ToolResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	Async        types.Bool   `tfsdk:"async"`
+	Function     types.Object `tfsdk:"function"`
+	Server       types.Object `tfsdk:"server"`
+	Messages     types.Object `tfsdk:"messages"`
+	TransferCall types.Object `tfsdk:"transfer_call"`
+	Query        types.Object `tfsdk:"query"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// InlineToolModel describes a tool defined inline in an assistant model. It
+// carries the same configuration as a vapi_tool without the identifier and timestamps.
+type InlineToolModel struct {
+	Type         types.String `tfsdk:"type"`
+	Async        types.Bool   `tfsdk:"async"`
+	Function     types.Object `tfsdk:"function"`
+	Server       types.Object `tfsdk:"server"`
+	Messages     types.Object `tfsdk:"messages"`
+	TransferCall types.Object `tfsdk:"transfer_call"`
+	Query        types.Object `tfsdk:"query"`
+}
+
+// toolModel converts the inline tool into a tool model so it can share the tool mappings
+func (m InlineToolModel) toolModel() ToolResourceModel {
+	return ToolResourceModel{
+		Type:         m.Type,
+		Async:        m.Async,
+		Function:     m.Function,
+		Server:       m.Server,
+		Messages:     m.Messages,
+		TransferCall: m.TransferCall,
+		Query:        m.Query,
+	}
+}
+
+// inlineToolModel is the inverse of InlineToolModel.toolModel
+func inlineToolModel(data ToolResourceModel) InlineToolModel {
+	return InlineToolModel{
+		Type:         data.Type,
+		Async:        data.Async,
+		Function:     data.Function,
+		Server:       data.Server,
+		Messages:     data.Messages,
+		TransferCall: data.TransferCall,
+		Query:        data.Query,
+	}
+}
+
+// ToolFunctionModel describes the function definition of a tool
+type ToolFunctionModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Parameters  types.String `tfsdk:"parameters"`
+	Strict      types.Bool   `tfsdk:"strict"`
+}
+
+// ToolServerModel describes the server that receives tool calls
+type ToolServerModel struct {
+	URL            types.String `tfsdk:"url"`
+	Secret         types.String `tfsdk:"secret"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+}
+
+// ToolMessagesModel describes the messages spoken while a tool call runs
+type ToolMessagesModel struct {
+	RequestStart    types.String `tfsdk:"request_start"`
+	RequestComplete types.String `tfsdk:"request_complete"`
+	RequestFailed   types.String `tfsdk:"request_failed"`
+}
+
+// ToolTransferCallModel describes the configuration of a transferCall tool
+type ToolTransferCallModel struct {
+	Destinations types.List `tfsdk:"destinations"`
+}
+
+// ToolDestinationModel describes a transfer destination
+type ToolDestinationModel struct {
+	Type          types.String `tfsdk:"type"`
+	Number        types.String `tfsdk:"number"`
+	SipURI        types.String `tfsdk:"sip_uri"`
+	AssistantName types.String `tfsdk:"assistant_name"`
+	Message       types.String `tfsdk:"message"`
+	Description   types.String `tfsdk:"description"`
+	Extension     types.String `tfsdk:"extension"`
+	CallerID      types.String `tfsdk:"caller_id"`
+}
+
+// ToolQueryModel describes the configuration of a query tool
+type ToolQueryModel struct {
+	KnowledgeBases types.List `tfsdk:"knowledge_bases"`
+}
+
+// ToolKnowledgeBaseModel describes a knowledge base searched by a query tool
+type ToolKnowledgeBaseModel struct {
+	Provider    types.String `tfsdk:"provider"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	FileIDs     types.List   `tfsdk:"file_ids"`
+}
+
+func toolFunctionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"parameters":  types.StringType,
+		"strict":      types.BoolType,
+	}
+}
+
+func toolServerAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url":             types.StringType,
+		"secret":          types.StringType,
+		"timeout_seconds": types.Int64Type,
+	}
+}
+
+func toolMessagesAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"request_start":    types.StringType,
+		"request_complete": types.StringType,
+		"request_failed":   types.StringType,
+	}
+}
+
+func toolDestinationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":           types.StringType,
+		"number":         types.StringType,
+		"sip_uri":        types.StringType,
+		"assistant_name": types.StringType,
+		"message":        types.StringType,
+		"description":    types.StringType,
+		"extension":      types.StringType,
+		"caller_id":      types.StringType,
+	}
+}
+
+func toolTransferCallAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"destinations": types.ListType{ElemType: types.ObjectType{AttrTypes: toolDestinationAttrTypes()}},
+	}
+}
+
+func toolKnowledgeBaseAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"provider":    types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"file_ids":    types.ListType{ElemType: types.StringType},
+	}
+}
+
+func toolQueryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"knowledge_bases": types.ListType{ElemType: types.ObjectType{AttrTypes: toolKnowledgeBaseAttrTypes()}},
+	}
+}
+
+// toolAttrTypes describes an inline tool, which carries the attributes of
+// toolAttributes without the identifier and timestamps
+func toolAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":          types.StringType,
+		"async":         types.BoolType,
+		"function":      types.ObjectType{AttrTypes: toolFunctionAttrTypes()},
+		"server":        types.ObjectType{AttrTypes: toolServerAttrTypes()},
+		"messages":      types.ObjectType{AttrTypes: toolMessagesAttrTypes()},
+		"transfer_call": types.ObjectType{AttrTypes: toolTransferCallAttrTypes()},
+		"query":         types.ObjectType{AttrTypes: toolQueryAttrTypes()},
+	}
+}
+
+func (r *ToolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool"
+}
+
+func (r *ToolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := toolAttributes(stringplanmodifier.RequiresReplace())
+	attributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Tool identifier",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["created_at"] = schema.StringAttribute{
+		MarkdownDescription: "Creation timestamp",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["updated_at"] = schema.StringAttribute{
+		MarkdownDescription: "Last update timestamp",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vapi Tool resource",
+
+		Attributes: attributes,
+	}
+}
+
+// toolAttributes returns the tool configuration attributes shared by vapi_tool
+// and the inline tools of an assistant model
+func toolAttributes(typePlanModifiers ...planmodifier.String) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Tool type (function, transferCall, endCall, dtmf, query)",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(toolTypes...),
+			},
+			PlanModifiers: typePlanModifiers,
+		},
+		"async": schema.BoolAttribute{
+			MarkdownDescription: "Whether the assistant continues the conversation without waiting for the function result. Only valid for function tools",
+			Optional:            true,
+		},
+		"function": schema.SingleNestedAttribute{
+			MarkdownDescription: "Function definition passed to the model. Required for function tools",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Function name",
+					Required:            true,
+				},
+				"description": schema.StringAttribute{
+					MarkdownDescription: "Description used by the model to decide when to call the function",
+					Optional:            true,
+				},
+				"parameters": schema.StringAttribute{
+					MarkdownDescription: "JSON schema of the function parameters, JSON-encoded",
+					Optional:            true,
+				},
+				"strict": schema.BoolAttribute{
+					MarkdownDescription: "Whether the model must follow the parameters schema exactly",
+					Optional:            true,
+				},
+			},
+		},
+		"server": schema.SingleNestedAttribute{
+			MarkdownDescription: "Server that receives the tool call",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					MarkdownDescription: "Server URL",
+					Required:            true,
+				},
+				"secret": schema.StringAttribute{
+					MarkdownDescription: "Secret sent with the request for verification",
+					Optional:            true,
+					Sensitive:           true,
+				},
+				"timeout_seconds": schema.Int64Attribute{
+					MarkdownDescription: "Request timeout in seconds",
+					Optional:            true,
+				},
+			},
+		},
+		"messages": schema.SingleNestedAttribute{
+			MarkdownDescription: "Messages spoken to the caller while the tool call runs",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"request_start": schema.StringAttribute{
+					MarkdownDescription: "Message spoken when the tool call starts",
+					Optional:            true,
+				},
+				"request_complete": schema.StringAttribute{
+					MarkdownDescription: "Message spoken when the tool call completes",
+					Optional:            true,
+				},
+				"request_failed": schema.StringAttribute{
+					MarkdownDescription: "Message spoken when the tool call fails",
+					Optional:            true,
+				},
+			},
+		},
+		"transfer_call": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration for transferCall tools",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"destinations": schema.ListNestedAttribute{
+					MarkdownDescription: "Destinations the call can be transferred to",
+					Required:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								MarkdownDescription: "Destination type (number, sip, assistant)",
+								Required:            true,
+							},
+							"number": schema.StringAttribute{
+								MarkdownDescription: "Phone number in E.164 format, for number destinations",
+								Optional:            true,
+							},
+							"sip_uri": schema.StringAttribute{
+								MarkdownDescription: "SIP URI, for sip destinations",
+								Optional:            true,
+							},
+							"assistant_name": schema.StringAttribute{
+								MarkdownDescription: "Assistant name, for assistant destinations",
+								Optional:            true,
+							},
+							"message": schema.StringAttribute{
+								MarkdownDescription: "Message spoken to the caller before the transfer",
+								Optional:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "Description used by the model to pick this destination",
+								Optional:            true,
+							},
+							"extension": schema.StringAttribute{
+								MarkdownDescription: "Extension to dial after the number connects",
+								Optional:            true,
+							},
+							"caller_id": schema.StringAttribute{
+								MarkdownDescription: "Caller ID presented to the destination",
+								Optional:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"query": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration for query tools",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"knowledge_bases": schema.ListNestedAttribute{
+					MarkdownDescription: "Knowledge bases searched by the tool",
+					Required:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"provider": schema.StringAttribute{
+								MarkdownDescription: "Knowledge base provider (e.g., google)",
+								Required:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "Knowledge base name",
+								Required:            true,
+							},
+							"description": schema.StringAttribute{
+								MarkdownDescription: "Description used by the model to decide when to search",
+								Optional:            true,
+							},
+							"file_ids": schema.ListAttribute{
+								MarkdownDescription: "IDs of the files in the knowledge base",
+								Required:            true,
+								ElementType:         types.StringType,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ToolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ToolResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTool(data, path.Empty())...)
+}
+
+// validateTool checks that the attributes set on a tool match its type. Paths
+// are reported relative to root, so inline assistant tools point at the list element.
+func validateTool(data ToolResourceModel, root path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Type.IsUnknown() || data.Type.IsNull() {
+		return diags
+	}
+
+	toolType := data.Type.ValueString()
+
+	if toolType == "function" && data.Function.IsNull() {
+		diags.AddAttributeError(
+			root.AtName("function"),
+			"Missing Function Definition",
+			"The function attribute is required when type is \"function\".",
+		)
+	}
+
+	if toolType != "function" && !data.Async.IsNull() {
+		diags.AddAttributeError(
+			root.AtName("async"),
+			"Invalid Attribute Combination",
+			"The async attribute can only be set when type is \"function\".",
+		)
+	}
+
+	if toolType != "transferCall" && !data.TransferCall.IsNull() {
+		diags.AddAttributeError(
+			root.AtName("transfer_call"),
+			"Invalid Attribute Combination",
+			"The transfer_call attribute can only be set when type is \"transferCall\".",
+		)
+	}
+
+	if toolType != "query" && !data.Query.IsNull() {
+		diags.AddAttributeError(
+			root.AtName("query"),
+			"Invalid Attribute Combination",
+			"The query attribute can only be set when type is \"query\".",
+		)
+	}
+
+	return diags
+}
+
+func (r *ToolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	tool, diags := toolFromModel(ctx, data, path.Empty())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the tool
+	createdTool, err := r.client.CreateTool(ctx, tool)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create tool", err, toolFieldRenames)
+		return
+	}
+
+	// Update the model with the created tool data
+	data.ID = types.StringValue(createdTool.ID)
+	data.CreatedAt = types.StringValue(createdTool.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdTool.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ToolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the tool from the API
+	tool, err := r.client.GetTool(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tool, got error: %s", err))
+		return
+	}
+
+	// Update the model with the tool data
+	resp.Diagnostics.Append(toolToModel(ctx, tool, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ToolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ToolResourceModel
+
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	tool, diags := toolFromModel(ctx, data, path.Empty())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The tool type is immutable and is rejected in update payloads
+	tool.Type = ""
+	tool.NullFields = toolNullFields(state, data)
+
+	// Update the tool
+	updatedTool, err := r.client.UpdateTool(ctx, data.ID.ValueString(), tool)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update tool", err, toolFieldRenames)
+		return
+	}
+
+	// Update the model with the updated tool data
+	data.UpdatedAt = types.StringValue(updatedTool.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ToolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ToolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the tool, treating one that is already gone as deleted
+	err := r.client.DeleteTool(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tool, got error: %s", err))
+		return
+	}
+}
+
+func (r *ToolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toolFromModel converts the Terraform model into the API payload. Paths are
+// reported relative to root, like validateTool.
+func toolFromModel(ctx context.Context, data ToolResourceModel, root path.Path) (*client.Tool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tool := &client.Tool{
+		Type: data.Type.ValueString(),
+	}
+
+	if !data.Async.IsNull() {
+		async := data.Async.ValueBool()
+		tool.Async = &async
+	}
+
+	if !data.Function.IsNull() {
+		var functionData ToolFunctionModel
+		diags.Append(data.Function.As(ctx, &functionData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		parameters, err := parseJSONObject(functionData.Parameters)
+		if err != nil {
+			diags.AddAttributeError(root.AtName("function").AtName("parameters"), "Invalid Function Parameters", err.Error())
+			return nil, diags
+		}
+
+		tool.Function = &client.ToolFunction{
+			Name:        functionData.Name.ValueString(),
+			Description: functionData.Description.ValueString(),
+			Parameters:  parameters,
+		}
+
+		if !functionData.Strict.IsNull() {
+			strict := functionData.Strict.ValueBool()
+			tool.Function.Strict = &strict
+		}
+	}
+
+	if !data.Server.IsNull() {
+		var serverData ToolServerModel
+		diags.Append(data.Server.As(ctx, &serverData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tool.Server = &client.ToolServer{
+			URL:    serverData.URL.ValueString(),
+			Secret: serverData.Secret.ValueString(),
+		}
+
+		if !serverData.TimeoutSeconds.IsNull() {
+			timeoutSeconds := int(serverData.TimeoutSeconds.ValueInt64())
+			tool.Server.TimeoutSeconds = &timeoutSeconds
+		}
+	}
+
+	if !data.Messages.IsNull() {
+		var messagesData ToolMessagesModel
+		diags.Append(data.Messages.As(ctx, &messagesData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		messages := []struct {
+			messageType string
+			content     types.String
+		}{
+			{"request-start", messagesData.RequestStart},
+			{"request-complete", messagesData.RequestComplete},
+			{"request-failed", messagesData.RequestFailed},
+		}
+		for _, message := range messages {
+			if !message.content.IsNull() {
+				tool.Messages = append(tool.Messages, client.ToolMessage{
+					Type:    message.messageType,
+					Content: message.content.ValueString(),
+				})
+			}
+		}
+	}
+
+	if !data.TransferCall.IsNull() {
+		var transferCallData ToolTransferCallModel
+		diags.Append(data.TransferCall.As(ctx, &transferCallData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var destinations []ToolDestinationModel
+		diags.Append(transferCallData.Destinations.ElementsAs(ctx, &destinations, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, destinationData := range destinations {
+			tool.Destinations = append(tool.Destinations, client.ToolDestination{
+				Type:          destinationData.Type.ValueString(),
+				Number:        destinationData.Number.ValueString(),
+				SipURI:        destinationData.SipURI.ValueString(),
+				AssistantName: destinationData.AssistantName.ValueString(),
+				Message:       destinationData.Message.ValueString(),
+				Description:   destinationData.Description.ValueString(),
+				Extension:     destinationData.Extension.ValueString(),
+				CallerID:      destinationData.CallerID.ValueString(),
+			})
+		}
+	}
+
+	if !data.Query.IsNull() {
+		var queryData ToolQueryModel
+		diags.Append(data.Query.As(ctx, &queryData, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var knowledgeBases []ToolKnowledgeBaseModel
+		diags.Append(queryData.KnowledgeBases.ElementsAs(ctx, &knowledgeBases, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, knowledgeBaseData := range knowledgeBases {
+			var fileIDs []string
+			diags.Append(knowledgeBaseData.FileIDs.ElementsAs(ctx, &fileIDs, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			tool.KnowledgeBases = append(tool.KnowledgeBases, client.ToolKnowledgeBase{
+				Provider:    knowledgeBaseData.Provider.ValueString(),
+				Name:        knowledgeBaseData.Name.ValueString(),
+				Description: knowledgeBaseData.Description.ValueString(),
+				FileIDs:     fileIDs,
+			})
+		}
+	}
+
+	return tool, diags
+}
+
+// toolNullFields returns the API fields that are set in the prior state but
+// removed from the plan, so the PATCH request clears them on the server.
+func toolNullFields(state, plan ToolResourceModel) []string {
+	candidates := []struct {
+		field   string
+		prior   attr.Value
+		planned attr.Value
+	}{
+		{"async", state.Async, plan.Async},
+		{"function", state.Function, plan.Function},
+		{"server", state.Server, plan.Server},
+		{"messages", state.Messages, plan.Messages},
+		{"destinations", state.TransferCall, plan.TransferCall},
+		{"knowledgeBases", state.Query, plan.Query},
+	}
+
+	var nullFields []string
+	for _, candidate := range candidates {
+		if !candidate.prior.IsNull() && candidate.planned.IsNull() {
+			nullFields = append(nullFields, candidate.field)
+		}
+	}
+
+	nullFields = append(nullFields, nestedNullFields("function", state.Function, plan.Function, map[string]string{
+		"description": "description",
+		"parameters":  "parameters",
+		"strict":      "strict",
+	})...)
+	nullFields = append(nullFields, nestedNullFields("server", state.Server, plan.Server, map[string]string{
+		"secret":          "secret",
+		"timeout_seconds": "timeoutSeconds",
+	})...)
+
+	return nullFields
+}
+
+// toolToModel copies the API response into the Terraform model. Optional
+// attributes missing from the prior state are only populated after an import,
+// when the prior state holds nothing but the ID.
+func toolToModel(ctx context.Context, tool *client.Tool, data *ToolResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	importing := data.Type.IsNull()
+
+	data.Type = types.StringValue(tool.Type)
+
+	// Vapi reports async=false on every function tool, only track it when configured
+	if tool.Async != nil && (*tool.Async || !data.Async.IsNull()) {
+		data.Async = types.BoolValue(*tool.Async)
+	} else {
+		data.Async = types.BoolNull()
+	}
+
+	// Non-function tools get a generated function definition, only track it when configured
+	if tool.Function != nil && (tool.Type == "function" || !data.Function.IsNull()) {
+		var priorFunction ToolFunctionModel
+		if !data.Function.IsNull() {
+			diags.Append(data.Function.As(ctx, &priorFunction, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		parameters, err := jsonStringValue(priorFunction.Parameters, tool.Function.Parameters)
+		if err != nil {
+			diags.AddError("Invalid Function Parameters", err.Error())
+			return diags
+		}
+
+		functionData := ToolFunctionModel{
+			Name:        types.StringValue(tool.Function.Name),
+			Description: stringValueOrNull(tool.Function.Description),
+			Parameters:  parameters,
+			Strict:      types.BoolNull(),
+		}
+		if tool.Function.Strict != nil && (*tool.Function.Strict || !priorFunction.Strict.IsNull()) {
+			functionData.Strict = types.BoolValue(*tool.Function.Strict)
+		}
+
+		functionObject, objectDiags := types.ObjectValueFrom(ctx, toolFunctionAttrTypes(), functionData)
+		diags.Append(objectDiags...)
+		data.Function = functionObject
+	} else {
+		data.Function = types.ObjectNull(toolFunctionAttrTypes())
+	}
+
+	if tool.Server != nil && tool.Server.URL != "" {
+		serverData := ToolServerModel{
+			URL:            types.StringValue(tool.Server.URL),
+			Secret:         types.StringNull(),
+			TimeoutSeconds: types.Int64Null(),
+		}
+
+		// Secrets are not refreshed from the API response
+		var priorServer ToolServerModel
+		if !data.Server.IsNull() {
+			diags.Append(data.Server.As(ctx, &priorServer, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return diags
+			}
+			serverData.Secret = priorServer.Secret
+		}
+
+		// Vapi fills in a default timeout, only track it when configured
+		serverData.TimeoutSeconds = refreshInt64(priorServer.TimeoutSeconds, tool.Server.TimeoutSeconds, importing)
+
+		serverObject, objectDiags := types.ObjectValueFrom(ctx, toolServerAttrTypes(), serverData)
+		diags.Append(objectDiags...)
+		data.Server = serverObject
+	} else {
+		data.Server = types.ObjectNull(toolServerAttrTypes())
+	}
+
+	messagesData := ToolMessagesModel{
+		RequestStart:    types.StringNull(),
+		RequestComplete: types.StringNull(),
+		RequestFailed:   types.StringNull(),
+	}
+	hasMessages := false
+	for _, message := range tool.Messages {
+		switch message.Type {
+		case "request-start":
+			messagesData.RequestStart = types.StringValue(message.Content)
+		case "request-complete":
+			messagesData.RequestComplete = types.StringValue(message.Content)
+		case "request-failed":
+			messagesData.RequestFailed = types.StringValue(message.Content)
+		default:
+			continue
+		}
+		hasMessages = true
+	}
+	if hasMessages {
+		messagesObject, objectDiags := types.ObjectValueFrom(ctx, toolMessagesAttrTypes(), messagesData)
+		diags.Append(objectDiags...)
+		data.Messages = messagesObject
+	} else {
+		data.Messages = types.ObjectNull(toolMessagesAttrTypes())
+	}
+
+	if tool.Type == "transferCall" && len(tool.Destinations) > 0 {
+		destinations := make([]ToolDestinationModel, 0, len(tool.Destinations))
+		for _, destination := range tool.Destinations {
+			destinations = append(destinations, ToolDestinationModel{
+				Type:          types.StringValue(destination.Type),
+				Number:        stringValueOrNull(destination.Number),
+				SipURI:        stringValueOrNull(destination.SipURI),
+				AssistantName: stringValueOrNull(destination.AssistantName),
+				Message:       stringValueOrNull(destination.Message),
+				Description:   stringValueOrNull(destination.Description),
+				Extension:     stringValueOrNull(destination.Extension),
+				CallerID:      stringValueOrNull(destination.CallerID),
+			})
+		}
+
+		destinationList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: toolDestinationAttrTypes()}, destinations)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		transferCallObject, objectDiags := types.ObjectValueFrom(ctx, toolTransferCallAttrTypes(), ToolTransferCallModel{
+			Destinations: destinationList,
+		})
+		diags.Append(objectDiags...)
+		data.TransferCall = transferCallObject
+	} else {
+		data.TransferCall = types.ObjectNull(toolTransferCallAttrTypes())
+	}
+
+	if tool.Type == "query" && len(tool.KnowledgeBases) > 0 {
+		knowledgeBases := make([]ToolKnowledgeBaseModel, 0, len(tool.KnowledgeBases))
+		for _, knowledgeBase := range tool.KnowledgeBases {
+			fileIDs, listDiags := types.ListValueFrom(ctx, types.StringType, knowledgeBase.FileIDs)
+			diags.Append(listDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			knowledgeBases = append(knowledgeBases, ToolKnowledgeBaseModel{
+				Provider:    types.StringValue(knowledgeBase.Provider),
+				Name:        types.StringValue(knowledgeBase.Name),
+				Description: stringValueOrNull(knowledgeBase.Description),
+				FileIDs:     fileIDs,
+			})
+		}
+
+		knowledgeBaseList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: toolKnowledgeBaseAttrTypes()}, knowledgeBases)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		queryObject, objectDiags := types.ObjectValueFrom(ctx, toolQueryAttrTypes(), ToolQueryModel{
+			KnowledgeBases: knowledgeBaseList,
+		})
+		diags.Append(objectDiags...)
+		data.Query = queryObject
+	} else {
+		data.Query = types.ObjectNull(toolQueryAttrTypes())
+	}
+
+	data.CreatedAt = types.StringValue(tool.CreatedAt)
+	data.UpdatedAt = types.StringValue(tool.UpdatedAt)
+
+	return diags
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// e164Regexp matches phone numbers in E.164 format, e.g. +14155550123
+var e164Regexp = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+var _ validator.String = httpURLValidator{}
+
+// httpURLValidator checks that a string is an absolute http or https URL
+type httpURLValidator struct{}
+
+func (v httpURLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v httpURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v httpURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Attribute %s %s, got: %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// httpURL returns a validator that checks for an absolute http or https URL
+func httpURL() validator.String {
+	return httpURLValidator{}
+}
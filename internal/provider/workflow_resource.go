@@ -0,0 +1,875 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkflowResource{}
+var _ resource.ResourceWithImportState = &WorkflowResource{}
+var _ resource.ResourceWithValidateConfig = &WorkflowResource{}
+
+// Workflow node types. Vapi only knows conversation and tool nodes: global
+// nodes are conversation nodes with a global node plan, and transfer and
+// end-call nodes are tool nodes with an inline transferCall or endCall tool.
+const (
+	workflowNodeConversation = "conversation"
+	workflowNodeGlobal       = "global"
+	workflowNodeTool         = "tool"
+	workflowNodeTransfer     = "transfer"
+	workflowNodeEndCall      = "end-call"
+)
+
+var workflowNodeTypes = []string{workflowNodeConversation, workflowNodeGlobal, workflowNodeTool, workflowNodeTransfer, workflowNodeEndCall}
+
+// workflowNodeAttributes lists the node types each type-specific node attribute applies to
+var workflowNodeAttributes = []struct {
+	attribute string
+	nodeTypes []string
+	required  bool
+}{
+	{"prompt", []string{workflowNodeConversation, workflowNodeGlobal}, false},
+	{"first_message", []string{workflowNodeConversation, workflowNodeGlobal}, false},
+	{"enter_condition", []string{workflowNodeGlobal}, true},
+	{"tool_id", []string{workflowNodeTool}, true},
+	{"destination", []string{workflowNodeTransfer}, true},
+}
+
+func NewWorkflowResource() resource.Resource {
+	return &WorkflowResource{}
+}
+
+// WorkflowResource defines the resource implementation.
+type WorkflowResource struct {
+	client *client.VapiClient
+}
+
+// WorkflowResourceModel describes the resource data model.
+type WorkflowResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	GlobalPrompt types.String `tfsdk:"global_prompt"`
+	Nodes        types.List   `tfsdk:"nodes"`
+	Edges        types.List   `tfsdk:"edges"`
+	OrgID        types.String `tfsdk:"org_id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// WorkflowNodeModel describes a workflow node. Its name identifies it in edges.
+type WorkflowNodeModel struct {
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	IsStart        types.Bool   `tfsdk:"is_start"`
+	Prompt         types.String `tfsdk:"prompt"`
+	FirstMessage   types.String `tfsdk:"first_message"`
+	EnterCondition types.String `tfsdk:"enter_condition"`
+	ToolID         types.String `tfsdk:"tool_id"`
+	Destination    types.Object `tfsdk:"destination"`
+}
+
+// WorkflowDestinationModel describes where a transfer node transfers the call
+type WorkflowDestinationModel struct {
+	Type    types.String `tfsdk:"type"`
+	Number  types.String `tfsdk:"number"`
+	SipURI  types.String `tfsdk:"sip_uri"`
+	Message types.String `tfsdk:"message"`
+}
+
+// WorkflowEdgeModel describes a transition between two nodes
+type WorkflowEdgeModel struct {
+	From           types.String `tfsdk:"from"`
+	To             types.String `tfsdk:"to"`
+	Condition      types.String `tfsdk:"condition"`
+	LogicCondition types.String `tfsdk:"logic_condition"`
+}
+
+func workflowDestinationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":    types.StringType,
+		"number":  types.StringType,
+		"sip_uri": types.StringType,
+		"message": types.StringType,
+	}
+}
+
+func workflowNodeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":            types.StringType,
+		"type":            types.StringType,
+		"is_start":        types.BoolType,
+		"prompt":          types.StringType,
+		"first_message":   types.StringType,
+		"enter_condition": types.StringType,
+		"tool_id":         types.StringType,
+		"destination":     types.ObjectType{AttrTypes: workflowDestinationAttrTypes()},
+	}
+}
+
+func workflowEdgeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"from":            types.StringType,
+		"to":              types.StringType,
+		"condition":       types.StringType,
+		"logic_condition": types.StringType,
+	}
+}
+
+func (r *WorkflowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vapi workflow resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Workflow identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the workflow",
+				Required:            true,
+			},
+			"global_prompt": schema.StringAttribute{
+				MarkdownDescription: "Prompt prepended to the prompt of every conversation node",
+				Optional:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "Steps of the workflow",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Unique name of the node, used to reference it in edges",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Node type (conversation, global, tool, transfer, end-call)",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(workflowNodeTypes...),
+							},
+						},
+						"is_start": schema.BoolAttribute{
+							MarkdownDescription: "Whether the workflow starts at this node",
+							Optional:            true,
+						},
+						"prompt": schema.StringAttribute{
+							MarkdownDescription: "Instructions for the model while the node is active. Conversation and global nodes only",
+							Optional:            true,
+						},
+						"first_message": schema.StringAttribute{
+							MarkdownDescription: "Message spoken when the node is entered. Conversation and global nodes only",
+							Optional:            true,
+						},
+						"enter_condition": schema.StringAttribute{
+							MarkdownDescription: "Condition under which the conversation jumps to this node from anywhere in the workflow. Required for global nodes",
+							Optional:            true,
+						},
+						"tool_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the tool the node runs. Required for tool nodes",
+							Optional:            true,
+						},
+						"destination": schema.SingleNestedAttribute{
+							MarkdownDescription: "Where the call is transferred. Required for transfer nodes",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Destination type (number, sip)",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("number", "sip"),
+									},
+								},
+								"number": schema.StringAttribute{
+									MarkdownDescription: "Phone number in E.164 format, for number destinations",
+									Optional:            true,
+								},
+								"sip_uri": schema.StringAttribute{
+									MarkdownDescription: "SIP URI, for sip destinations",
+									Optional:            true,
+								},
+								"message": schema.StringAttribute{
+									MarkdownDescription: "Message spoken to the caller before the transfer",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				MarkdownDescription: "Transitions between nodes",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							MarkdownDescription: "Name of the node the edge leaves",
+							Required:            true,
+						},
+						"to": schema.StringAttribute{
+							MarkdownDescription: "Name of the node the edge enters",
+							Required:            true,
+						},
+						"condition": schema.StringAttribute{
+							MarkdownDescription: "Natural language condition the model evaluates to take the edge",
+							Optional:            true,
+						},
+						"logic_condition": schema.StringAttribute{
+							MarkdownDescription: "Liquid expression that takes the edge when it evaluates to true, e.g. `{{ age >= 18 }}`",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization the workflow belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that node names are unique, that each node only sets
+// the attributes of its type and that edges connect existing nodes.
+func (r *WorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorkflowResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Nodes.IsUnknown() || data.Nodes.IsNull() {
+		return
+	}
+
+	var nodes []WorkflowNodeModel
+	resp.Diagnostics.Append(data.Nodes.ElementsAs(ctx, &nodes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Edges can't be checked against names that are only known after apply
+	namesKnown := true
+	names := make(map[string]bool, len(nodes))
+	startNodes := 0
+
+	for i, node := range nodes {
+		nodePath := path.Root("nodes").AtListIndex(i)
+
+		if node.Name.IsUnknown() {
+			namesKnown = false
+		} else if names[node.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				nodePath.AtName("name"),
+				"Duplicate Node Name",
+				fmt.Sprintf("Node names identify nodes in edges and must be unique, %q is used more than once.", node.Name.ValueString()),
+			)
+		} else {
+			names[node.Name.ValueString()] = true
+		}
+
+		if node.IsStart.ValueBool() {
+			startNodes++
+			if startNodes > 1 {
+				resp.Diagnostics.AddAttributeError(
+					nodePath.AtName("is_start"),
+					"Invalid Attribute Combination",
+					"Only one node can be the start node.",
+				)
+			}
+		}
+
+		if node.Type.IsUnknown() || node.Type.IsNull() {
+			continue
+		}
+
+		nodeType := node.Type.ValueString()
+
+		if nodeType == workflowNodeGlobal && node.IsStart.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				nodePath.AtName("is_start"),
+				"Invalid Attribute Combination",
+				"A global node is entered through its enter_condition and can't be the start node.",
+			)
+		}
+
+		values := map[string]attr.Value{
+			"prompt":          node.Prompt,
+			"first_message":   node.FirstMessage,
+			"enter_condition": node.EnterCondition,
+			"tool_id":         node.ToolID,
+			"destination":     node.Destination,
+		}
+
+		for _, spec := range workflowNodeAttributes {
+			applies := false
+			for _, t := range spec.nodeTypes {
+				if t == nodeType {
+					applies = true
+					break
+				}
+			}
+
+			value := values[spec.attribute]
+
+			if applies && spec.required && value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					nodePath.AtName(spec.attribute),
+					"Missing Attribute Configuration",
+					fmt.Sprintf("%s is required for %s nodes.", spec.attribute, nodeType),
+				)
+			}
+
+			if !applies && !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					nodePath.AtName(spec.attribute),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("%s can't be set on %s nodes.", spec.attribute, nodeType),
+				)
+			}
+		}
+
+		if !node.Destination.IsNull() && !node.Destination.IsUnknown() {
+			var destination WorkflowDestinationModel
+			diags := node.Destination.As(ctx, &destination, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+
+			if destination.Type.ValueString() == "number" && destination.Number.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					nodePath.AtName("destination").AtName("number"),
+					"Missing Attribute Configuration",
+					"number is required for number destinations.",
+				)
+			}
+
+			if destination.Type.ValueString() == "sip" && destination.SipURI.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					nodePath.AtName("destination").AtName("sip_uri"),
+					"Missing Attribute Configuration",
+					"sip_uri is required for sip destinations.",
+				)
+			}
+		}
+	}
+
+	if data.Edges.IsUnknown() || data.Edges.IsNull() {
+		return
+	}
+
+	var edges []WorkflowEdgeModel
+	diags := data.Edges.ElementsAs(ctx, &edges, false)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for i, edge := range edges {
+		edgePath := path.Root("edges").AtListIndex(i)
+
+		if !edge.Condition.IsNull() && !edge.LogicCondition.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				edgePath.AtName("logic_condition"),
+				"Invalid Attribute Combination",
+				"An edge can have a condition or a logic_condition, not both.",
+			)
+		}
+
+		if !namesKnown {
+			continue
+		}
+
+		for _, endpoint := range []struct {
+			attribute string
+			value     types.String
+		}{
+			{"from", edge.From},
+			{"to", edge.To},
+		} {
+			if endpoint.value.IsUnknown() || endpoint.value.IsNull() || names[endpoint.value.ValueString()] {
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				edgePath.AtName(endpoint.attribute),
+				"Unknown Node",
+				fmt.Sprintf("No node is named %q.", endpoint.value.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *WorkflowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.VapiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.VapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	workflow, diags := workflowFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the workflow
+	createdWorkflow, err := r.client.CreateWorkflow(ctx, workflow)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create workflow", err, nil)
+		return
+	}
+
+	// Update the model with the created workflow data
+	data.ID = types.StringValue(createdWorkflow.ID)
+	data.OrgID = types.StringValue(createdWorkflow.OrgID)
+	data.CreatedAt = types.StringValue(createdWorkflow.CreatedAt)
+	data.UpdatedAt = types.StringValue(createdWorkflow.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the workflow from the API
+	workflow, err := r.client.GetWorkflow(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	// Update the model with the workflow data
+	resp.Diagnostics.Append(workflowToModel(ctx, workflow, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorkflowResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state WorkflowResourceModel
+
+	// Read Terraform prior state data so attributes removed from the configuration can be cleared
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Convert Terraform model to API model
+	workflow, diags := workflowFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.GlobalPrompt.IsNull() && data.GlobalPrompt.IsNull() {
+		workflow.NullFields = append(workflow.NullFields, "globalPrompt")
+	}
+
+	// Update the workflow
+	updatedWorkflow, err := r.client.UpdateWorkflow(ctx, data.ID.ValueString(), workflow)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update workflow", err, nil)
+		return
+	}
+
+	// Update the model with the updated workflow data
+	data.OrgID = types.StringValue(updatedWorkflow.OrgID)
+	data.CreatedAt = types.StringValue(updatedWorkflow.CreatedAt)
+	data.UpdatedAt = types.StringValue(updatedWorkflow.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkflowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the workflow, treating one that is already gone as deleted
+	err := r.client.DeleteWorkflow(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow, got error: %s", err))
+		return
+	}
+}
+
+func (r *WorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// workflowFromModel converts the Terraform model into the API payload
+func workflowFromModel(ctx context.Context, data WorkflowResourceModel) (*client.Workflow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	workflow := &client.Workflow{
+		Name:         data.Name.ValueString(),
+		GlobalPrompt: data.GlobalPrompt.ValueString(),
+		Nodes:        []client.WorkflowNode{},
+		Edges:        []client.WorkflowEdge{},
+	}
+
+	var nodes []WorkflowNodeModel
+	diags.Append(data.Nodes.ElementsAs(ctx, &nodes, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, nodeData := range nodes {
+		node := client.WorkflowNode{
+			Name: nodeData.Name.ValueString(),
+		}
+
+		if !nodeData.IsStart.IsNull() {
+			isStart := nodeData.IsStart.ValueBool()
+			node.IsStart = &isStart
+		}
+
+		switch nodeData.Type.ValueString() {
+		case workflowNodeConversation, workflowNodeGlobal:
+			node.Type = "conversation"
+			node.Prompt = nodeData.Prompt.ValueString()
+
+			if !nodeData.FirstMessage.IsNull() {
+				node.MessagePlan = &client.WorkflowMessagePlan{FirstMessage: nodeData.FirstMessage.ValueString()}
+			}
+
+			if nodeData.Type.ValueString() == workflowNodeGlobal {
+				node.GlobalNodePlan = &client.WorkflowGlobalNodePlan{
+					Enabled:        true,
+					EnterCondition: nodeData.EnterCondition.ValueString(),
+				}
+			}
+		case workflowNodeTool:
+			node.Type = "tool"
+			node.ToolID = nodeData.ToolID.ValueString()
+		case workflowNodeTransfer:
+			node.Type = "tool"
+			node.Tool = &client.Tool{Type: "transferCall"}
+
+			if !nodeData.Destination.IsNull() {
+				var destinationData WorkflowDestinationModel
+				diags.Append(nodeData.Destination.As(ctx, &destinationData, basetypes.ObjectAsOptions{})...)
+				if diags.HasError() {
+					return nil, diags
+				}
+
+				node.Tool.Destinations = []client.ToolDestination{{
+					Type:    destinationData.Type.ValueString(),
+					Number:  destinationData.Number.ValueString(),
+					SipURI:  destinationData.SipURI.ValueString(),
+					Message: destinationData.Message.ValueString(),
+				}}
+			}
+		case workflowNodeEndCall:
+			node.Type = "tool"
+			node.Tool = &client.Tool{Type: "endCall"}
+		}
+
+		workflow.Nodes = append(workflow.Nodes, node)
+	}
+
+	if !data.Edges.IsNull() {
+		var edges []WorkflowEdgeModel
+		diags.Append(data.Edges.ElementsAs(ctx, &edges, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, edgeData := range edges {
+			edge := client.WorkflowEdge{
+				From: edgeData.From.ValueString(),
+				To:   edgeData.To.ValueString(),
+			}
+
+			if !edgeData.Condition.IsNull() {
+				edge.Condition = &client.WorkflowEdgeCondition{Type: "ai", Prompt: edgeData.Condition.ValueString()}
+			} else if !edgeData.LogicCondition.IsNull() {
+				edge.Condition = &client.WorkflowEdgeCondition{Type: "logic", Liquid: edgeData.LogicCondition.ValueString()}
+			}
+
+			workflow.Edges = append(workflow.Edges, edge)
+		}
+	}
+
+	return workflow, diags
+}
+
+// workflowToModel copies the API response into the Terraform model. Nodes and
+// edges are kept in the configured order, matched by node name and by their
+// endpoints and condition, so a reordered response doesn't show up as a diff. Elements that
+// only exist remotely are appended after the configured ones.
+func workflowToModel(ctx context.Context, workflow *client.Workflow, data *WorkflowResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	importing := data.Nodes.IsNull()
+
+	var priorNodes []WorkflowNodeModel
+	if !data.Nodes.IsNull() && !data.Nodes.IsUnknown() {
+		diags.Append(data.Nodes.ElementsAs(ctx, &priorNodes, false)...)
+	}
+
+	var priorEdges []WorkflowEdgeModel
+	if !data.Edges.IsNull() && !data.Edges.IsUnknown() {
+		diags.Append(data.Edges.ElementsAs(ctx, &priorEdges, false)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	priorNodesByName := make(map[string]WorkflowNodeModel, len(priorNodes))
+	nodeOrder := make([]string, 0, len(priorNodes))
+	for _, node := range priorNodes {
+		priorNodesByName[node.Name.ValueString()] = node
+		nodeOrder = append(nodeOrder, node.Name.ValueString())
+	}
+
+	nodes := make([]WorkflowNodeModel, 0, len(workflow.Nodes))
+	for _, node := range orderedByKey(workflow.Nodes, nodeOrder, func(n client.WorkflowNode) string { return n.Name }) {
+		nodeData, nodeDiags := workflowNodeToModel(ctx, node, priorNodesByName[node.Name], importing)
+		diags.Append(nodeDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		nodes = append(nodes, nodeData)
+	}
+
+	nodeList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workflowNodeAttrTypes()}, nodes)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Several edges can connect the same pair of nodes under different
+	// conditions, so the condition is part of what identifies an edge
+	edgeKey := func(from, to, conditionType, condition string) string {
+		return strings.Join([]string{from, to, conditionType, condition}, "\x00")
+	}
+
+	edgeOrder := make([]string, 0, len(priorEdges))
+	for _, edge := range priorEdges {
+		conditionType, condition := "", ""
+		switch {
+		case !edge.Condition.IsNull():
+			conditionType, condition = "ai", edge.Condition.ValueString()
+		case !edge.LogicCondition.IsNull():
+			conditionType, condition = "logic", edge.LogicCondition.ValueString()
+		}
+		edgeOrder = append(edgeOrder, edgeKey(edge.From.ValueString(), edge.To.ValueString(), conditionType, condition))
+	}
+
+	apiEdgeKey := func(e client.WorkflowEdge) string {
+		if e.Condition == nil {
+			return edgeKey(e.From, e.To, "", "")
+		}
+		condition := e.Condition.Prompt
+		if e.Condition.Type == "logic" {
+			condition = e.Condition.Liquid
+		}
+		return edgeKey(e.From, e.To, e.Condition.Type, condition)
+	}
+
+	edges := make([]WorkflowEdgeModel, 0, len(workflow.Edges))
+	for _, edge := range orderedByKey(workflow.Edges, edgeOrder, apiEdgeKey) {
+		edgeData := WorkflowEdgeModel{
+			From:           types.StringValue(edge.From),
+			To:             types.StringValue(edge.To),
+			Condition:      types.StringNull(),
+			LogicCondition: types.StringNull(),
+		}
+
+		if edge.Condition != nil {
+			switch edge.Condition.Type {
+			case "ai":
+				edgeData.Condition = types.StringValue(edge.Condition.Prompt)
+			case "logic":
+				edgeData.LogicCondition = types.StringValue(edge.Condition.Liquid)
+			}
+		}
+
+		edges = append(edges, edgeData)
+	}
+
+	// A workflow without edges keeps edges unset rather than an empty list
+	if len(edges) == 0 && data.Edges.IsNull() {
+		data.Edges = types.ListNull(types.ObjectType{AttrTypes: workflowEdgeAttrTypes()})
+	} else {
+		edgeList, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workflowEdgeAttrTypes()}, edges)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.Edges = edgeList
+	}
+
+	data.Name = types.StringValue(workflow.Name)
+	data.GlobalPrompt = refreshString(data.GlobalPrompt, workflow.GlobalPrompt, importing)
+	data.Nodes = nodeList
+	data.OrgID = types.StringValue(workflow.OrgID)
+	data.CreatedAt = types.StringValue(workflow.CreatedAt)
+	data.UpdatedAt = types.StringValue(workflow.UpdatedAt)
+
+	return diags
+}
+
+// workflowNodeToModel converts an API node into its Terraform node type, the
+// reverse of the mapping in workflowFromModel
+func workflowNodeToModel(ctx context.Context, node client.WorkflowNode, prior WorkflowNodeModel, importing bool) (WorkflowNodeModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nodeData := WorkflowNodeModel{
+		Name:           types.StringValue(node.Name),
+		IsStart:        refreshBool(prior.IsStart, node.IsStart, importing),
+		Prompt:         types.StringNull(),
+		FirstMessage:   types.StringNull(),
+		EnterCondition: types.StringNull(),
+		ToolID:         types.StringNull(),
+		Destination:    types.ObjectNull(workflowDestinationAttrTypes()),
+	}
+
+	switch {
+	case node.Type == "conversation":
+		nodeData.Type = types.StringValue(workflowNodeConversation)
+		nodeData.Prompt = refreshString(prior.Prompt, node.Prompt, importing)
+
+		if node.MessagePlan != nil {
+			nodeData.FirstMessage = refreshString(prior.FirstMessage, node.MessagePlan.FirstMessage, importing)
+		}
+
+		if node.GlobalNodePlan != nil && node.GlobalNodePlan.Enabled {
+			nodeData.Type = types.StringValue(workflowNodeGlobal)
+			nodeData.EnterCondition = stringValueOrNull(node.GlobalNodePlan.EnterCondition)
+		}
+	case node.Tool != nil && node.Tool.Type == "transferCall":
+		nodeData.Type = types.StringValue(workflowNodeTransfer)
+
+		if len(node.Tool.Destinations) > 0 {
+			destination := node.Tool.Destinations[0]
+
+			var priorDestination WorkflowDestinationModel
+			if !prior.Destination.IsNull() {
+				diags.Append(prior.Destination.As(ctx, &priorDestination, basetypes.ObjectAsOptions{})...)
+				if diags.HasError() {
+					return nodeData, diags
+				}
+			}
+
+			destinationObject, objectDiags := types.ObjectValueFrom(ctx, workflowDestinationAttrTypes(), WorkflowDestinationModel{
+				Type:    types.StringValue(destination.Type),
+				Number:  stringValueOrNull(destination.Number),
+				SipURI:  stringValueOrNull(destination.SipURI),
+				Message: refreshString(priorDestination.Message, destination.Message, importing),
+			})
+			diags.Append(objectDiags...)
+			nodeData.Destination = destinationObject
+		}
+	case node.Tool != nil && node.Tool.Type == "endCall":
+		nodeData.Type = types.StringValue(workflowNodeEndCall)
+	default:
+		nodeData.Type = types.StringValue(workflowNodeTool)
+		nodeData.ToolID = stringValueOrNull(node.ToolID)
+	}
+
+	return nodeData, diags
+}
@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-vapi/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowNodes builds a nodes list from node attribute values
+func workflowNodes(t *testing.T, nodes ...map[string]attr.Value) types.List {
+	t.Helper()
+
+	elements := make([]attr.Value, 0, len(nodes))
+	for _, node := range nodes {
+		elements = append(elements, objectValue(t, workflowNodeAttrTypes(), node))
+	}
+
+	return types.ListValueMust(types.ObjectType{AttrTypes: workflowNodeAttrTypes()}, elements)
+}
+
+// workflowEdges builds an edges list from edge attribute values
+func workflowEdges(t *testing.T, edges ...map[string]attr.Value) types.List {
+	t.Helper()
+
+	elements := make([]attr.Value, 0, len(edges))
+	for _, edge := range edges {
+		elements = append(elements, objectValue(t, workflowEdgeAttrTypes(), edge))
+	}
+
+	return types.ListValueMust(types.ObjectType{AttrTypes: workflowEdgeAttrTypes()}, elements)
+}
+
+// workflowNode returns the attribute values of a node with the given name and type
+func workflowNode(name, nodeType string, values map[string]attr.Value) map[string]attr.Value {
+	values["name"] = types.StringValue(name)
+	values["type"] = types.StringValue(nodeType)
+	return values
+}
+
+// workflowEdge returns the attribute values of an edge between two nodes
+func workflowEdge(from, to string, values map[string]attr.Value) map[string]attr.Value {
+	values["from"] = types.StringValue(from)
+	values["to"] = types.StringValue(to)
+	return values
+}
+
+func TestWorkflowValidateConfig(t *testing.T) {
+	start := func() map[string]attr.Value {
+		return workflowNode("greeting", "conversation", map[string]attr.Value{
+			"is_start": types.BoolValue(true),
+			"prompt":   types.StringValue("Greet the caller"),
+		})
+	}
+	hangUp := func() map[string]attr.Value {
+		return workflowNode("hang_up", "end-call", map[string]attr.Value{})
+	}
+
+	tests := []struct {
+		name  string
+		nodes []map[string]attr.Value
+		edges []map[string]attr.Value
+		want  []string
+	}{
+		{
+			name: "every node type",
+			nodes: []map[string]attr.Value{
+				start(),
+				workflowNode("escalate", "global", map[string]attr.Value{
+					"enter_condition": types.StringValue("The caller asks for a human"),
+				}),
+				workflowNode("lookup", "tool", map[string]attr.Value{"tool_id": types.StringValue("tool-1")}),
+				workflowNode("transfer", "transfer", map[string]attr.Value{
+					"destination": objectValue(t, workflowDestinationAttrTypes(), map[string]attr.Value{
+						"type":   types.StringValue("number"),
+						"number": types.StringValue("+14155550100"),
+					}),
+				}),
+				hangUp(),
+			},
+			edges: []map[string]attr.Value{
+				workflowEdge("greeting", "lookup", map[string]attr.Value{"condition": types.StringValue("The caller gave an order number")}),
+				workflowEdge("lookup", "hang_up", map[string]attr.Value{"logic_condition": types.StringValue("{{ found }}")}),
+			},
+			want: nil,
+		},
+		{
+			name:  "duplicate node names",
+			nodes: []map[string]attr.Value{start(), workflowNode("greeting", "end-call", map[string]attr.Value{})},
+			want:  []string{"nodes[1].name"},
+		},
+		{
+			name: "several start nodes",
+			nodes: []map[string]attr.Value{
+				start(),
+				workflowNode("hang_up", "end-call", map[string]attr.Value{"is_start": types.BoolValue(true)}),
+			},
+			want: []string{"nodes[1].is_start"},
+		},
+		{
+			name: "global start node",
+			nodes: []map[string]attr.Value{
+				workflowNode("escalate", "global", map[string]attr.Value{
+					"is_start":        types.BoolValue(true),
+					"enter_condition": types.StringValue("The caller asks for a human"),
+				}),
+			},
+			want: []string{"nodes[0].is_start"},
+		},
+		{
+			name: "missing type specific attributes",
+			nodes: []map[string]attr.Value{
+				workflowNode("escalate", "global", map[string]attr.Value{}),
+				workflowNode("lookup", "tool", map[string]attr.Value{}),
+				workflowNode("transfer", "transfer", map[string]attr.Value{}),
+			},
+			want: []string{"nodes[0].enter_condition", "nodes[1].tool_id", "nodes[2].destination"},
+		},
+		{
+			name: "attributes of other node types",
+			nodes: []map[string]attr.Value{
+				workflowNode("lookup", "tool", map[string]attr.Value{
+					"tool_id": types.StringValue("tool-1"),
+					"prompt":  types.StringValue("Look up the order"),
+				}),
+				workflowNode("hang_up", "end-call", map[string]attr.Value{"first_message": types.StringValue("Goodbye")}),
+			},
+			want: []string{"nodes[0].prompt", "nodes[1].first_message"},
+		},
+		{
+			name: "destinations without an address",
+			nodes: []map[string]attr.Value{
+				workflowNode("transfer", "transfer", map[string]attr.Value{
+					"destination": objectValue(t, workflowDestinationAttrTypes(), map[string]attr.Value{"type": types.StringValue("number")}),
+				}),
+				workflowNode("transfer_sip", "transfer", map[string]attr.Value{
+					"destination": objectValue(t, workflowDestinationAttrTypes(), map[string]attr.Value{"type": types.StringValue("sip")}),
+				}),
+			},
+			want: []string{"nodes[0].destination.number", "nodes[1].destination.sip_uri"},
+		},
+		{
+			name:  "edge with both conditions",
+			nodes: []map[string]attr.Value{start(), hangUp()},
+			edges: []map[string]attr.Value{
+				workflowEdge("greeting", "hang_up", map[string]attr.Value{
+					"condition":       types.StringValue("The caller is done"),
+					"logic_condition": types.StringValue("{{ done }}"),
+				}),
+			},
+			want: []string{"edges[0].logic_condition"},
+		},
+		{
+			name:  "edge to an unknown node",
+			nodes: []map[string]attr.Value{start(), hangUp()},
+			edges: []map[string]attr.Value{
+				workflowEdge("greting", "hang_up", map[string]attr.Value{}),
+				workflowEdge("greeting", "goodbye", map[string]attr.Value{}),
+			},
+			want: []string{"edges[0].from", "edges[1].to"},
+		},
+		{
+			name: "node names not known until apply",
+			nodes: []map[string]attr.Value{
+				start(),
+				{"name": types.StringUnknown(), "type": types.StringValue("end-call")},
+			},
+			edges: []map[string]attr.Value{workflowEdge("greeting", "goodbye", map[string]attr.Value{})},
+			want:  nil,
+		},
+	}
+
+	r := NewWorkflowResource().(*WorkflowResource)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]attr.Value{
+				"name":  types.StringValue("Orders"),
+				"nodes": workflowNodes(t, tt.nodes...),
+			}
+			if tt.edges != nil {
+				config["edges"] = workflowEdges(t, tt.edges...)
+			}
+
+			if got := validateConfigErrors(t, r, config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got errors at %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// testWorkflowModel returns a workflow model with the given nodes and edges
+func testWorkflowModel(t *testing.T, nodes []map[string]attr.Value, edges []map[string]attr.Value) WorkflowResourceModel {
+	data := WorkflowResourceModel{
+		Name:         types.StringValue("Orders"),
+		GlobalPrompt: types.StringNull(),
+		Nodes:        workflowNodes(t, nodes...),
+		Edges:        types.ListNull(types.ObjectType{AttrTypes: workflowEdgeAttrTypes()}),
+	}
+	if edges != nil {
+		data.Edges = workflowEdges(t, edges...)
+	}
+
+	return data
+}
+
+func TestWorkflowFromModel(t *testing.T) {
+	enabled := true
+
+	data := testWorkflowModel(t,
+		[]map[string]attr.Value{
+			workflowNode("greeting", "conversation", map[string]attr.Value{
+				"is_start":      types.BoolValue(true),
+				"prompt":        types.StringValue("Greet the caller"),
+				"first_message": types.StringValue("Hi!"),
+			}),
+			workflowNode("escalate", "global", map[string]attr.Value{
+				"prompt":          types.StringValue("Apologize"),
+				"enter_condition": types.StringValue("The caller asks for a human"),
+			}),
+			workflowNode("lookup", "tool", map[string]attr.Value{"tool_id": types.StringValue("tool-1")}),
+			workflowNode("transfer", "transfer", map[string]attr.Value{
+				"destination": objectValue(t, workflowDestinationAttrTypes(), map[string]attr.Value{
+					"type":    types.StringValue("number"),
+					"number":  types.StringValue("+14155550100"),
+					"message": types.StringValue("Transferring you now"),
+				}),
+			}),
+			workflowNode("hang_up", "end-call", map[string]attr.Value{}),
+		},
+		[]map[string]attr.Value{
+			workflowEdge("greeting", "lookup", map[string]attr.Value{"condition": types.StringValue("The caller gave an order number")}),
+			workflowEdge("lookup", "hang_up", map[string]attr.Value{"logic_condition": types.StringValue("{{ found }}")}),
+			workflowEdge("escalate", "transfer", map[string]attr.Value{}),
+		},
+	)
+	data.GlobalPrompt = types.StringValue("You take orders")
+
+	want := &client.Workflow{
+		Name:         "Orders",
+		GlobalPrompt: "You take orders",
+		Nodes: []client.WorkflowNode{
+			{
+				Type:        "conversation",
+				Name:        "greeting",
+				IsStart:     &enabled,
+				Prompt:      "Greet the caller",
+				MessagePlan: &client.WorkflowMessagePlan{FirstMessage: "Hi!"},
+			},
+			{
+				Type:           "conversation",
+				Name:           "escalate",
+				Prompt:         "Apologize",
+				GlobalNodePlan: &client.WorkflowGlobalNodePlan{Enabled: true, EnterCondition: "The caller asks for a human"},
+			},
+			{Type: "tool", Name: "lookup", ToolID: "tool-1"},
+			{
+				Type: "tool",
+				Name: "transfer",
+				Tool: &client.Tool{
+					Type: "transferCall",
+					Destinations: []client.ToolDestination{{
+						Type:    "number",
+						Number:  "+14155550100",
+						Message: "Transferring you now",
+					}},
+				},
+			},
+			{Type: "tool", Name: "hang_up", Tool: &client.Tool{Type: "endCall"}},
+		},
+		Edges: []client.WorkflowEdge{
+			{From: "greeting", To: "lookup", Condition: &client.WorkflowEdgeCondition{Type: "ai", Prompt: "The caller gave an order number"}},
+			{From: "lookup", To: "hang_up", Condition: &client.WorkflowEdgeCondition{Type: "logic", Liquid: "{{ found }}"}},
+			{From: "escalate", To: "transfer"},
+		},
+	}
+
+	got, diags := workflowFromModel(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestWorkflowToModel(t *testing.T) {
+	enabled := true
+
+	tests := []struct {
+		name     string
+		workflow *client.Workflow
+		prior    func(*testing.T) WorkflowResourceModel
+		want     func(*testing.T) WorkflowResourceModel
+	}{
+		{
+			name: "reordered response keeps the configured order",
+			workflow: &client.Workflow{
+				Name: "Orders",
+				Nodes: []client.WorkflowNode{
+					{Type: "tool", Name: "hang_up", Tool: &client.Tool{Type: "endCall"}},
+					{Type: "conversation", Name: "greeting", IsStart: &enabled, Prompt: "Greet the caller"},
+				},
+				Edges: []client.WorkflowEdge{
+					{From: "greeting", To: "hang_up", Condition: &client.WorkflowEdgeCondition{Type: "logic", Liquid: "{{ done }}"}},
+					{From: "greeting", To: "hang_up", Condition: &client.WorkflowEdgeCondition{Type: "ai", Prompt: "The caller is done"}},
+				},
+			},
+			prior: func(t *testing.T) WorkflowResourceModel {
+				return testWorkflowModel(t,
+					[]map[string]attr.Value{
+						workflowNode("greeting", "conversation", map[string]attr.Value{"prompt": types.StringValue("Greet the caller")}),
+						workflowNode("hang_up", "end-call", map[string]attr.Value{}),
+					},
+					[]map[string]attr.Value{
+						workflowEdge("greeting", "hang_up", map[string]attr.Value{"condition": types.StringValue("The caller is done")}),
+						workflowEdge("greeting", "hang_up", map[string]attr.Value{"logic_condition": types.StringValue("{{ done }}")}),
+					},
+				)
+			},
+			want: func(t *testing.T) WorkflowResourceModel {
+				// is_start stays null, it isn't in the prior state
+				return testWorkflowModel(t,
+					[]map[string]attr.Value{
+						workflowNode("greeting", "conversation", map[string]attr.Value{"prompt": types.StringValue("Greet the caller")}),
+						workflowNode("hang_up", "end-call", map[string]attr.Value{}),
+					},
+					[]map[string]attr.Value{
+						workflowEdge("greeting", "hang_up", map[string]attr.Value{"condition": types.StringValue("The caller is done")}),
+						workflowEdge("greeting", "hang_up", map[string]attr.Value{"logic_condition": types.StringValue("{{ done }}")}),
+					},
+				)
+			},
+		},
+		{
+			name: "nodes added outside Terraform follow the configured ones",
+			workflow: &client.Workflow{
+				Name: "Orders",
+				Nodes: []client.WorkflowNode{
+					{Type: "tool", Name: "lookup", ToolID: "tool-1"},
+					{Type: "conversation", Name: "greeting", Prompt: "Greet the caller"},
+				},
+				Edges: []client.WorkflowEdge{},
+			},
+			prior: func(t *testing.T) WorkflowResourceModel {
+				return testWorkflowModel(t,
+					[]map[string]attr.Value{
+						workflowNode("greeting", "conversation", map[string]attr.Value{"prompt": types.StringValue("Greet the caller")}),
+					},
+					nil,
+				)
+			},
+			want: func(t *testing.T) WorkflowResourceModel {
+				return testWorkflowModel(t,
+					[]map[string]attr.Value{
+						workflowNode("greeting", "conversation", map[string]attr.Value{"prompt": types.StringValue("Greet the caller")}),
+						workflowNode("lookup", "tool", map[string]attr.Value{"tool_id": types.StringValue("tool-1")}),
+					},
+					nil,
+				)
+			},
+		},
+		{
+			name: "import maps every node type",
+			workflow: &client.Workflow{
+				Name:         "Orders",
+				GlobalPrompt: "You take orders",
+				Nodes: []client.WorkflowNode{
+					{Type: "conversation", Name: "greeting", IsStart: &enabled, Prompt: "Greet the caller", MessagePlan: &client.WorkflowMessagePlan{FirstMessage: "Hi!"}},
+					{Type: "conversation", Name: "escalate", GlobalNodePlan: &client.WorkflowGlobalNodePlan{Enabled: true, EnterCondition: "The caller asks for a human"}},
+					{Type: "tool", Name: "transfer", Tool: &client.Tool{
+						Type:         "transferCall",
+						Destinations: []client.ToolDestination{{Type: "sip", SipURI: "sip:support@example.com", Message: "Transferring you now"}},
+					}},
+					{Type: "tool", Name: "hang_up", Tool: &client.Tool{Type: "endCall"}},
+				},
+				Edges: []client.WorkflowEdge{
+					{From: "escalate", To: "transfer"},
+				},
+			},
+			prior: func(t *testing.T) WorkflowResourceModel {
+				return WorkflowResourceModel{
+					Nodes: types.ListNull(types.ObjectType{AttrTypes: workflowNodeAttrTypes()}),
+					Edges: types.ListNull(types.ObjectType{AttrTypes: workflowEdgeAttrTypes()}),
+				}
+			},
+			want: func(t *testing.T) WorkflowResourceModel {
+				data := testWorkflowModel(t,
+					[]map[string]attr.Value{
+						workflowNode("greeting", "conversation", map[string]attr.Value{
+							"is_start":      types.BoolValue(true),
+							"prompt":        types.StringValue("Greet the caller"),
+							"first_message": types.StringValue("Hi!"),
+						}),
+						workflowNode("escalate", "global", map[string]attr.Value{
+							"enter_condition": types.StringValue("The caller asks for a human"),
+						}),
+						workflowNode("transfer", "transfer", map[string]attr.Value{
+							"destination": objectValue(t, workflowDestinationAttrTypes(), map[string]attr.Value{
+								"type":    types.StringValue("sip"),
+								"sip_uri": types.StringValue("sip:support@example.com"),
+								"message": types.StringValue("Transferring you now"),
+							}),
+						}),
+						workflowNode("hang_up", "end-call", map[string]attr.Value{}),
+					},
+					[]map[string]attr.Value{workflowEdge("escalate", "transfer", map[string]attr.Value{})},
+				)
+				data.GlobalPrompt = types.StringValue("You take orders")
+				return data
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.prior(t)
+
+			if diags := workflowToModel(context.Background(), tt.workflow, &data); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			want := tt.want(t)
+			want.OrgID = types.StringValue("")
+			want.CreatedAt = types.StringValue("")
+			want.UpdatedAt = types.StringValue("")
+
+			if !reflect.DeepEqual(data, want) {
+				t.Errorf("got %+v, want %+v", data, want)
+			}
+		})
+	}
+}